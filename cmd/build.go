@@ -54,6 +54,7 @@ type BuildFlags struct {
 	GPU            string
 	NoCache        bool
 	WASM           bool
+	OutputFormat   string // --output: text (default), json, or yaml
 }
 
 // newBuildCmd creates the build command.
@@ -116,9 +117,15 @@ Examples:
   kdeps build examples/chatbot --no-cache`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := resolveOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			flags.OutputFormat = format
 			return BuildImageWithFlagsInternal(cmd, args, flags)
 		},
 	}
+	addOutputFormatFlag(buildCmd)
 
 	buildCmd.Flags().StringVar(&flags.Tag, "tag", "", "Docker image tag")
 	buildCmd.Flags().
@@ -38,6 +38,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
@@ -53,6 +54,12 @@ func setupDockerBuilder(flags *BuildFlags) (*docker.Builder, error) {
 // setupDockerBuilderImpl is the default Docker builder setup implementation.
 func setupDockerBuilderImpl(flags *BuildFlags) (*docker.Builder, error) {
 	kdeps_debug.Log("enter: setupDockerBuilder")
+	if IsNativeMode() {
+		return nil, errors.New(
+			"native mode (KDEPS_NATIVE_MODE): container image builds are disabled — " +
+				"run the agent with 'kdeps run --native' instead",
+		)
+	}
 	// Auto-select OS based on GPU type
 	selectedOS := defaultBaseImage
 	if flags.GPU != "" {
@@ -39,9 +39,12 @@ func performDockerBuild(
 	flags *BuildFlags,
 ) error {
 	kdeps_debug.Log("enter: performDockerBuild")
-	fmt.Fprintln(os.Stdout, "✓ Package extracted")
-	fmt.Fprintln(os.Stdout, "✓ Dockerfile generated")
-	fmt.Fprintln(os.Stdout, "✓ Building image...")
+	textOut := !isStructuredOutput(flags.OutputFormat)
+	if textOut {
+		fmt.Fprintln(os.Stdout, "✓ Package extracted")
+		fmt.Fprintln(os.Stdout, "✓ Dockerfile generated")
+		fmt.Fprintln(os.Stdout, "✓ Building image...")
+	}
 
 	imageName, err := dockerBuildImageFunc(builder, workflow, packagePath, flags.NoCache)
 	if err != nil {
@@ -54,16 +57,27 @@ func performDockerBuild(
 		if tagErr := builder.Client.TagImage(ctx, imageName, flags.Tag); tagErr != nil {
 			return fmt.Errorf("failed to tag image: %w", tagErr)
 		}
-		fmt.Fprintf(os.Stdout, "✓ Image tagged: %s\n", flags.Tag)
+		if textOut {
+			fmt.Fprintf(os.Stdout, "✓ Image tagged: %s\n", flags.Tag)
+		}
 		imageName = flags.Tag
 	}
 
+	ports := getWorkflowPorts(workflow)
+	if !textOut {
+		return emitStructured(os.Stdout, flags.OutputFormat, buildResult{
+			Image:    imageName,
+			Workflow: workflow.Metadata.Name,
+			Version:  workflow.Metadata.Version,
+			Ports:    ports,
+		})
+	}
+
 	fmt.Fprintln(os.Stdout)
 	fmt.Fprintln(os.Stdout, "✅ Image built successfully!")
 	fmt.Fprintf(os.Stdout, "  Image: %s\n", imageName)
 	fmt.Fprintln(os.Stdout)
 
-	ports := getWorkflowPorts(workflow)
 	var portFlags []string
 	for _, p := range ports {
 		portFlags = append(portFlags, fmt.Sprintf("-p %d:%d", p, p))
@@ -75,6 +89,14 @@ func performDockerBuild(
 	return nil
 }
 
+// buildResult is the stable schema emitted by build --output json|yaml.
+type buildResult struct {
+	Image    string `json:"image"    yaml:"image"`
+	Workflow string `json:"workflow" yaml:"workflow"`
+	Version  string `json:"version"  yaml:"version"`
+	Ports    []int  `json:"ports"    yaml:"ports"`
+}
+
 // buildDockerImage is a variable so tests can replace it without running Docker.
 
 //nolint:gochecknoglobals // overridable in tests
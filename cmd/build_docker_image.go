@@ -66,7 +66,9 @@ func buildImageInternal(cmd *cobra.Command, args []string, flags *BuildFlags) er
 	}
 
 	packagePath := args[0]
-	fmt.Fprintf(os.Stdout, "Building Docker image from: %s\n\n", packagePath)
+	if !isStructuredOutput(flags.OutputFormat) {
+		fmt.Fprintf(os.Stdout, "Building Docker image from: %s\n\n", packagePath)
+	}
 
 	pkg, err := LoadWorkflowPackage(packagePath, LoadWorkflowPackageOpts{
 		Chdir:           true,
@@ -49,7 +49,8 @@ also loaded if present, and takes precedence over the global config.
 Examples:
   kdeps exec invoice-extractor
   kdeps exec autopilot --file /path/to/input.txt`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeInstalledAgents,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			kdeps_debug.Log("enter: execCmd.RunE")
 			return runInstalledAgent(cmd, args[0], flags)
@@ -61,6 +62,7 @@ Examples:
 	cmd.Flags().StringVar(&flags.FileArg, "file", "", "Path to input file (file-source agents only)")
 	cmd.Flags().BoolVar(&flags.Events, "events", false, "Emit structured NDJSON events to stderr")
 	cmd.Flags().BoolVar(&flags.Interactive, "interactive", false, "Force interactive LLM REPL")
+	cmd.Flags().BoolVar(&flags.Native, "native", false, "Run in no-container native mode (preflights host requirements)")
 	cmd.Flags().Bool("debug", false, "Enable debug logging")
 	return cmd
 }
@@ -74,6 +76,14 @@ func runInstalledAgent(cmd *cobra.Command, name string, flags *RunFlags) error {
 	if err != nil {
 		return err
 	}
+	if isolated, isoErr := runAgentIsolated(name); isolated || isoErr != nil {
+		return isoErr
+	}
+	if flags.Native {
+		if preflightErr := nativePreflight(os.Stdout); preflightErr != nil {
+			return preflightErr
+		}
+	}
 	return ExecuteWorkflowStepsWithFlags(cmd, workflowPath, flags)
 }
 
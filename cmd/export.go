@@ -58,6 +58,7 @@ func newExportCmd() *cobra.Command {
 
 	exportCmd.AddCommand(newExportISOCmd())
 	exportCmd.AddCommand(newExportK8sCmd())
+	exportCmd.AddCommand(newExportOfflineCmd())
 
 	return exportCmd
 }
@@ -22,6 +22,8 @@ package cmd
 
 import (
 	"archive/tar"
+	"fmt"
+	"os"
 	"path/filepath"
 
 	kdeps_debug "github.com/kdeps/kdeps/v2/pkg/debug"
@@ -33,8 +35,10 @@ import (
 
 // PackageFlags holds the flags for the package command.
 type PackageFlags struct {
-	Output string
-	Name   string
+	Output       string
+	Name         string
+	AllowSecrets bool   // --allow-secrets: package despite credential findings
+	OutputFormat string // "json" or "yaml" when --output selects a machine-readable format
 }
 
 // newPackageCmd creates the package command.
@@ -82,13 +86,24 @@ Examples:
   kdeps package my-agent/ --name custom-agent`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			// --output doubles as the machine-readable format selector:
+			// "json"/"yaml" keep the default output directory.
+			if flags.Output == OutputFormatJSON || flags.Output == OutputFormatYAML {
+				flags.OutputFormat = flags.Output
+				flags.Output = "."
+			}
 			return PackageAutoWithFlags(cmd, args, flags)
 		},
 	}
 
-	packageCmd.Flags().StringVar(&flags.Output, "output", ".", "Output directory")
+	packageCmd.Flags().StringVar(&flags.Output, "output", ".",
+		"Output directory, or \"json\"/\"yaml\" for machine-readable output to stdout")
 	packageCmd.Flags().
 		StringVar(&flags.Name, "name", "", "Package name (default: from workflow/agency)")
+	packageCmd.Flags().BoolVar(
+		&flags.AllowSecrets, "allow-secrets", false,
+		"Package even when credential patterns are detected in the sources",
+	)
 
 	return packageCmd
 }
@@ -140,3 +155,18 @@ var tarFileInfoHeaderFunc = tar.FileInfoHeader
 //
 //nolint:gochecknoglobals // test-replaceable hook
 var filepathRelIgnoreFunc = filepath.Rel
+
+// packageResult is the stable schema emitted by package --output json|yaml.
+type packageResult struct {
+	Archive string `json:"archive" yaml:"archive"`
+	Type    string `json:"type"    yaml:"type"`
+}
+
+// printPackagePreamble prints the human packaging preamble unless a
+// machine-readable format is selected.
+func printPackagePreamble(flags *PackageFlags, format string, args ...interface{}) {
+	if isStructuredOutput(flags.OutputFormat) {
+		return
+	}
+	fmt.Fprintf(os.Stdout, format, args...)
+}
@@ -40,7 +40,10 @@ const kagencyExtension = ".kagency"
 const komponentExtension = ".komponent"
 
 // printAgencyPackageSuccess prints the post-package summary for agencies.
-func printAgencyPackageSuccess(archivePath string) {
+func printAgencyPackageSuccess(flags *PackageFlags, archivePath string) error {
+	if isStructuredOutput(flags.OutputFormat) {
+		return emitStructured(os.Stdout, flags.OutputFormat, packageResult{Archive: archivePath, Type: "agency"})
+	}
 	fmt.Fprintln(os.Stdout, "✓ Agency manifest validated")
 	fmt.Fprintln(os.Stdout, "✓ Agent sub-directories collected")
 	fmt.Fprintln(os.Stdout, "✓ Package created")
@@ -51,6 +54,7 @@ func printAgencyPackageSuccess(archivePath string) {
 	fmt.Fprintf(os.Stdout, "  kdeps run %s\n", archivePath)
 	fmt.Fprintf(os.Stdout, "  kdeps build %s\n", archivePath)
 	fmt.Fprintf(os.Stdout, "  kdeps export iso %s\n", archivePath)
+	return nil
 }
 
 // PackageAgencyWithFlags packages an agency directory into a .kagency archive.
@@ -58,7 +62,7 @@ func printAgencyPackageSuccess(archivePath string) {
 func PackageAgencyWithFlags(_ *cobra.Command, args []string, flags *PackageFlags) error {
 	kdeps_debug.Log("enter: PackageAgencyWithFlags")
 	agencyDir := args[0]
-	fmt.Fprintf(os.Stdout, "Packaging agency: %s\n\n", agencyDir)
+	printPackagePreamble(flags, "Packaging agency: %s\n\n", agencyDir)
 
 	agencyFile := FindAgencyFile(agencyDir)
 	if agencyFile == "" {
@@ -84,8 +88,7 @@ func PackageAgencyWithFlags(_ *cobra.Command, args []string, flags *PackageFlags
 		return fmt.Errorf("failed to create agency archive: %w", archiveErr)
 	}
 
-	printAgencyPackageSuccess(archivePath)
-	return nil
+	return printAgencyPackageSuccess(flags, archivePath)
 }
 
 // CreateAgencyPackageArchive creates a .kagency tar.gz archive from agencyDir.
@@ -58,6 +58,27 @@ func CreatePackageArchive(sourceDir, archivePath string, _ *domain.Workflow) err
 	// Parse .kdepsignore patterns
 	ignorePatterns := ParseKdepsIgnore(sourceDir)
 
+	// Integrity manifest: file list, sizes, and SHA-256 of everything
+	// packaged, verified at load time so corrupted or partially-copied
+	// packages fail fast.
+	manifest, err := BuildIntegrityManifest(sourceDir, func(relPath string) bool {
+		return IsIgnored(relPath, ignorePatterns)
+	})
+	if err != nil {
+		return fmt.Errorf("build integrity manifest: %w", err)
+	}
+	manifestHeader := &tar.Header{
+		Name: IntegrityManifestName,
+		Mode: 0o600,
+		Size: int64(len(manifest)),
+	}
+	if err := tarWriter.WriteHeader(manifestHeader); err != nil {
+		return fmt.Errorf("write manifest header: %w", err)
+	}
+	if _, err := tarWriter.Write(manifest); err != nil {
+		return fmt.Errorf("write manifest: %w", err)
+	}
+
 	// Walk through source directory and add files
 	return filepath.Walk(sourceDir, CreateArchiveWalkFunc(sourceDir, tarWriter, ignorePatterns))
 }
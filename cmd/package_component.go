@@ -34,19 +34,23 @@ import (
 )
 
 // printComponentPackageSuccess prints the post-package summary for components.
-func printComponentPackageSuccess(archivePath string) {
+func printComponentPackageSuccess(flags *PackageFlags, archivePath string) error {
+	if isStructuredOutput(flags.OutputFormat) {
+		return emitStructured(os.Stdout, flags.OutputFormat, packageResult{Archive: archivePath, Type: "component"})
+	}
 	fmt.Fprintln(os.Stdout, "✓ Component manifest validated")
 	fmt.Fprintln(os.Stdout, "✓ Resources collected")
 	fmt.Fprintln(os.Stdout, "✓ Package created")
 	fmt.Fprintln(os.Stdout)
 	fmt.Fprintf(os.Stdout, "Created: %s\n", archivePath)
+	return nil
 }
 
 // PackageComponentWithFlags packages a component directory into a .komponent archive.
 func PackageComponentWithFlags(_ *cobra.Command, args []string, flags *PackageFlags) error {
 	kdeps_debug.Log("enter: PackageComponentWithFlags")
 	componentDir := args[0]
-	fmt.Fprintf(os.Stdout, "Packaging component: %s\n\n", componentDir)
+	printPackagePreamble(flags, "Packaging component: %s\n\n", componentDir)
 
 	componentFile := FindComponentFile(componentDir)
 	if componentFile == "" {
@@ -72,8 +76,7 @@ func PackageComponentWithFlags(_ *cobra.Command, args []string, flags *PackageFl
 		return fmt.Errorf("failed to create component archive: %w", archiveErr)
 	}
 
-	printComponentPackageSuccess(archivePath)
-	return nil
+	return printComponentPackageSuccess(flags, archivePath)
 }
 
 // CreateComponentPackageArchive creates a .komponent tar.gz archive from componentDir.
@@ -56,7 +56,10 @@ func resolvePackageOutputDir(flags *PackageFlags, defaultName string) (string, s
 }
 
 // printWorkflowPackageSuccess prints the post-package summary for workflows.
-func printWorkflowPackageSuccess(archivePath string) {
+func printWorkflowPackageSuccess(flags *PackageFlags, archivePath string) error {
+	if isStructuredOutput(flags.OutputFormat) {
+		return emitStructured(os.Stdout, flags.OutputFormat, packageResult{Archive: archivePath, Type: "workflow"})
+	}
 	fmt.Fprintln(os.Stdout, "✓ Workflow validated")
 	fmt.Fprintln(os.Stdout, "✓ Resources collected")
 	fmt.Fprintln(os.Stdout, "✓ Dependencies resolved")
@@ -66,18 +69,23 @@ func printWorkflowPackageSuccess(archivePath string) {
 	fmt.Fprintln(os.Stdout)
 	fmt.Fprintln(os.Stdout, "Next steps:")
 	fmt.Fprintf(os.Stdout, "  kdeps build %s\n", archivePath)
+	return nil
 }
 
 // PackageWorkflowWithFlags packages a workflow into a .kdeps file with injected flags.
 func PackageWorkflowWithFlags(_ *cobra.Command, args []string, flags *PackageFlags) error {
 	kdeps_debug.Log("enter: PackageWorkflowWithFlags")
 	workflowDir := args[0]
-	fmt.Fprintf(os.Stdout, "Packaging: %s\n\n", workflowDir)
+	printPackagePreamble(flags, "Packaging: %s\n\n", workflowDir)
 
 	if err := ValidateWorkflowDir(workflowDir); err != nil {
 		return fmt.Errorf("invalid workflow directory: %w", err)
 	}
 
+	if scanErr := refuseOnSecretFindings(workflowDir, flags.AllowSecrets); scanErr != nil {
+		return scanErr
+	}
+
 	workflowPath := findWorkflowFilePackageFunc(workflowDir)
 	if workflowPath == "" {
 		return fmt.Errorf(
@@ -110,8 +118,7 @@ func PackageWorkflowWithFlags(_ *cobra.Command, args []string, flags *PackageFla
 		kdepslog.Warn("failed to generate docker-compose.yml", "error", composeErr)
 	}
 
-	printWorkflowPackageSuccess(archivePath)
-	return nil
+	return printWorkflowPackageSuccess(flags, archivePath)
 }
 
 // ValidateWorkflowDir checks if the directory contains a valid workflow.
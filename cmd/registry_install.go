@@ -47,7 +47,7 @@ const (
 // newRegistryInstallCmd creates the registry install subcommand.
 func newRegistryInstallCmd() *cobra.Command {
 	kdeps_debug.Log("enter: newRegistryInstallCmd")
-	return &cobra.Command{
+	installCmd := &cobra.Command{
 		Use:   "install <package[@version] | owner/repo[:subdir] | /path/to/file.kdeps>",
 		Short: "Install a workflow, agency, or component from the registry, GitHub, or a local file.",
 		Long: `Install a package from the kdeps registry, a GitHub repository, or a local archive.
@@ -74,6 +74,8 @@ Behavior depends on package type:
 			return doRegistryInstall(cmd, args[0], registryURL(cmd))
 		},
 	}
+	addOutputFormatFlag(installCmd)
+	return installCmd
 }
 
 // isLocalFilePath reports whether ref should be treated as a local filesystem path.
@@ -55,6 +55,11 @@ func installWorkflowOrAgency(cmd *cobra.Command, manifest *domain.KdepsPkg, arch
 	if extractErr := extractArchive(archivePath, destDir); extractErr != nil {
 		return extractErr
 	}
+	dedupAgentData(destDir)
+
+	if handled, emitErr := emitInstallResult(cmd, manifest, version, destDir); handled {
+		return emitErr
+	}
 
 	w := cmd.OutOrStdout()
 	fmt.Fprintln(w)
@@ -107,6 +112,10 @@ func installRegistryComponent(cmd *cobra.Command, manifest *domain.KdepsPkg, arc
 		return extractErr
 	}
 
+	if handled, emitErr := emitInstallResult(cmd, manifest, version, destDir); handled {
+		return emitErr
+	}
+
 	w := cmd.OutOrStdout()
 	fmt.Fprintln(w)
 	fmt.Fprintf(w, "✓ Component %s @%s installed to %s\n", manifest.Name, version, destDir)
@@ -159,3 +168,29 @@ func peekManifest(archivePath string) (*domain.KdepsPkg, error) {
 	}
 	return nil, nil //nolint:nilnil // nil manifest means no kdeps.pkg.yaml found; caller handles this
 }
+
+// installResult is the stable schema emitted by registry install --output json|yaml.
+type installResult struct {
+	Name    string `json:"name"    yaml:"name"`
+	Type    string `json:"type"    yaml:"type"`
+	Version string `json:"version" yaml:"version"`
+	Path    string `json:"path"    yaml:"path"`
+}
+
+// emitInstallResult writes the machine-readable install summary when
+// --output json|yaml is set. handled is false for text output.
+func emitInstallResult(cmd *cobra.Command, manifest *domain.KdepsPkg, version, destDir string) (bool, error) {
+	format, err := resolveOutputFormat(cmd)
+	if err != nil {
+		return true, err
+	}
+	if !isStructuredOutput(format) {
+		return false, nil
+	}
+	return true, emitStructured(cmd.OutOrStdout(), format, installResult{
+		Name:    manifest.Name,
+		Type:    manifest.Type,
+		Version: version,
+		Path:    destDir,
+	})
+}
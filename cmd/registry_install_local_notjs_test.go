@@ -112,7 +112,7 @@ func TestRegistryListRunE_HomeError(t *testing.T) {
 	tmp := t.TempDir()
 	t.Setenv("HOME", tmp)
 	userHomeDirFunc = func() (string, error) { return tmp, nil }
-	require.NoError(t, registryListRunE())
+	require.NoError(t, registryListRunE(newRegistryListCmd()))
 }
 
 func TestDownloadRegistryArchive_MkdirInstallError(t *testing.T) {
@@ -316,7 +316,7 @@ func TestRegistryListRunE_GlobalErr(t *testing.T) {
 	// componentInstallDir may still work; test list with temp HOME
 	tmp := t.TempDir()
 	t.Setenv("HOME", tmp)
-	require.NoError(t, registryListRunE())
+	require.NoError(t, registryListRunE(newRegistryListCmd()))
 }
 
 func TestResolveInstalledAgentWorkflow_NoHome(t *testing.T) {
@@ -33,37 +33,56 @@ import (
 // newRegistryListCmd creates the registry list subcommand.
 func newRegistryListCmd() *cobra.Command {
 	kdeps_debug.Log("enter: newRegistryListCmd")
-	return &cobra.Command{
+	listCmd := &cobra.Command{
 		Use:   "list",
 		Short: "List installed components and agents",
-		RunE: func(_ *cobra.Command, _ []string) error {
+		RunE: func(cmd *cobra.Command, _ []string) error {
 			kdeps_debug.Log("enter: registry list RunE")
-			return registryListRunE()
+			return registryListRunE(cmd)
 		},
 	}
+	addOutputFormatFlag(listCmd)
+	return listCmd
+}
+
+// installedListing is the stable schema emitted by registry list --output json|yaml.
+type installedListing struct {
+	GlobalComponents []string `json:"globalComponents" yaml:"globalComponents"`
+	LocalComponents  []string `json:"localComponents"  yaml:"localComponents"`
+	InstalledAgents  []string `json:"installedAgents"  yaml:"installedAgents"`
+	LocalAgents      []string `json:"localAgents"      yaml:"localAgents"`
 }
 
 // registryListRunE implements the registry list command logic.
-func registryListRunE() error {
+func registryListRunE(cmd *cobra.Command) error {
+	format, err := resolveOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	globalDir, err := componentInstallDir()
 	if err != nil {
 		return err
 	}
 
-	printSection("Global components:", listLocalComponents(globalDir))
-	printSection("Local components (./components/):", listLocalComponents("components"))
-	printInstalledAgentSections()
-
-	return nil
-}
+	listing := installedListing{
+		GlobalComponents: listLocalComponents(globalDir),
+		LocalComponents:  listLocalComponents("components"),
+		LocalAgents:      listInstalledAgents("agents"),
+	}
+	if agentsDir, dirErr := kdepsAgentsDir(); dirErr == nil {
+		listing.InstalledAgents = listInstalledAgents(agentsDir)
+	}
 
-// printInstalledAgentSections prints global and local installed agent sections.
-func printInstalledAgentSections() {
-	agentsDir, err := kdepsAgentsDir()
-	if err == nil {
-		printSection("Installed agents (~/.kdeps/agents/):", listInstalledAgents(agentsDir))
+	if isStructuredOutput(format) {
+		return emitStructured(cmd.OutOrStdout(), format, listing)
 	}
-	printSection("Local agents (./agents/):", listInstalledAgents("agents"))
+
+	printSection("Global components:", listing.GlobalComponents)
+	printSection("Local components (./components/):", listing.LocalComponents)
+	printSection("Installed agents (~/.kdeps/agents/):", listing.InstalledAgents)
+	printSection("Local agents (./agents/):", listing.LocalAgents)
+
+	return nil
 }
 
 // printSection prints a labelled section of names when the slice is non-empty.
@@ -49,7 +49,7 @@ func TestRegistryListRunE_HomeFail(t *testing.T) {
 	}
 	t.Setenv("KDEPS_COMPONENT_DIR", "")
 	t.Setenv("HOME", "")
-	require.Error(t, registryListRunE())
+	require.Error(t, registryListRunE(newRegistryListCmd()))
 }
 
 func TestListInstalledAgents_SkipFiles(t *testing.T) {
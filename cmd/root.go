@@ -207,13 +207,20 @@ func addDevelopCommands(rootCmd *cobra.Command) {
 	addCommandToGroup(rootCmd, groupDevelop, newEditCmd())
 	addCommandToGroup(rootCmd, groupDevelop, newValidateCmd())
 	addCommandToGroup(rootCmd, groupDevelop, newRunCmd())
+	addCommandToGroup(rootCmd, groupDevelop, newUpCmd())
 	addCommandToGroup(rootCmd, groupDevelop, newChatCmd())
+	addCommandToGroup(rootCmd, groupDevelop, newEvalCmd())
+	addCommandToGroup(rootCmd, groupDevelop, newConfigCmd())
+	addCommandToGroup(rootCmd, groupDevelop, newReplayCmd())
+	addCommandToGroup(rootCmd, groupDevelop, newCaptureCmd())
+	addCommandToGroup(rootCmd, groupDevelop, newDocsCmd())
 	addCommandToGroup(rootCmd, groupDevelop, newDoctorCmd())
 	addCommandToGroup(rootCmd, groupDevelop, newLlamafileCmd())
 }
 
 func addPackageCommands(rootCmd *cobra.Command) {
 	addCommandToGroup(rootCmd, groupPackage, newBundleCmd())
+	addCommandToGroup(rootCmd, groupPackage, newArtifactsCmd())
 }
 
 func addDistributeCommands(rootCmd *cobra.Command) {
@@ -223,4 +230,9 @@ func addDistributeCommands(rootCmd *cobra.Command) {
 func addDeployCommands(rootCmd *cobra.Command) {
 	addCommandToGroup(rootCmd, groupDeploy, newExecCmd())
 	addCommandToGroup(rootCmd, groupDeploy, newExportCmd())
+	addCommandToGroup(rootCmd, groupDeploy, newRunsCmd())
+	addCommandToGroup(rootCmd, groupDeploy, newTopCmd())
+	addCommandToGroup(rootCmd, groupDeploy, newServiceCmd())
+	addCommandToGroup(rootCmd, groupDeploy, newQueueCmd())
+	addCommandToGroup(rootCmd, groupDeploy, newImportCmd())
 }
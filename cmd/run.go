@@ -21,6 +21,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
@@ -45,11 +46,17 @@ const (
 
 // RunFlags holds the flags for the run command.
 type RunFlags struct {
-	Port        int
-	DevMode     bool
-	FileArg     string // --file: path to the file to process (file input source only; overrides stdin/KDEPS_FILE_PATH/config)
-	Events      bool   // --events: emit structured NDJSON execution events to stderr
-	Interactive bool   // --interactive: force interactive LLM REPL for any workflow/agency regardless of configured input source
+	Port          int
+	DevMode       bool
+	FileArg       string // --file: path to the file to process (file input source only; overrides stdin/KDEPS_FILE_PATH/config)
+	Events        bool   // --events: emit structured NDJSON execution events to stderr
+	Interactive   bool   // --interactive: force interactive LLM REPL for any workflow/agency regardless of configured input source
+	Reindex       bool   // --reindex: bypass the embedding content-hash cache so vectors are recomputed
+	FailOnWarning bool   // --fail-on-warning: exit non-zero when any warning was logged during the run
+	Native        bool   // --native: no-container mode; preflight host requirements and never touch a container runtime
+	Remote        string // --remote: base URL of a deployed agent to run against instead of local execution
+	RemoteRoute   string // --route: API route to invoke on the remote agent (default "/")
+	RemoteData    string // --data: JSON request body sent to the remote agent
 }
 
 func newRunCmd() *cobra.Command {
@@ -89,9 +96,20 @@ Examples:
   # Start interactive LLM REPL alongside normal workflow execution
   kdeps run workflow.yaml --interactive
   kdeps run my-agency.kagency --interactive`,
-		Args: cobra.ExactArgs(1),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return RunWorkflowWithFlags(cmd, args, flags)
+			if flags.Remote != "" {
+				return runRemoteWorkflow(
+					cmd.OutOrStdout(), flags.Remote, flags.RemoteRoute, flags.RemoteData,
+				)
+			}
+			if len(args) != 1 {
+				return errors.New("requires a workflow path (or --remote)")
+			}
+			if err := RunWorkflowWithFlags(cmd, args, flags); err != nil {
+				return err
+			}
+			return checkFailOnWarning(flags)
 		},
 	}
 
@@ -106,6 +124,31 @@ Examples:
 		&flags.Events, "events", false,
 		"Emit structured NDJSON execution events to stderr (resource lifecycle, failure classification).",
 	)
+	runCmd.Flags().BoolVar(
+		&flags.Native, "native", false,
+		"Run fully on the host (API server, Ollama, Python venvs, sandbox dirs) without any container runtime. "+
+			"Preflights host requirements with clear diagnostics.",
+	)
+	runCmd.Flags().StringVar(
+		&flags.Remote, "remote", "",
+		"Base URL of a deployed agent: the request is sent there (with auth from config.yaml) instead of executing locally.",
+	)
+	runCmd.Flags().StringVar(
+		&flags.RemoteRoute, "route", "",
+		"API route to invoke on the remote agent (default \"/\"). Only with --remote.",
+	)
+	runCmd.Flags().StringVar(
+		&flags.RemoteData, "data", "",
+		"JSON request body for the remote invocation. Only with --remote.",
+	)
+	runCmd.Flags().BoolVar(
+		&flags.Reindex, "reindex", false,
+		"Bypass the embedding content-hash cache: recompute all vectors (results are written back to the cache).",
+	)
+	runCmd.Flags().BoolVar(
+		&flags.FailOnWarning, "fail-on-warning", false,
+		"Exit with a non-zero status when any warning was logged during the run.",
+	)
 	runCmd.Flags().BoolVar(
 		&flags.Interactive, "interactive", false,
 		"Run the workflow as normal and simultaneously open an interactive LLM REPL in the terminal. "+
@@ -134,6 +177,14 @@ func RunWorkflowWithFlags(cmd *cobra.Command, args []string, flags *RunFlags) er
 	if debugMode {
 		fmt.Fprintln(os.Stdout, "🐛 Debug mode: Enabled")
 	}
+	if flags.Reindex {
+		_ = os.Setenv("KDEPS_EMBEDDING_REINDEX", "true")
+	}
+	if flags.Native {
+		if preflightErr := nativePreflight(os.Stdout); preflightErr != nil {
+			return preflightErr
+		}
+	}
 
 	// Resolve workflow path and get cleanup function
 	workflowPath, cleanup, err := resolveWorkflowPath(inputPath)
@@ -49,6 +49,12 @@ func toExecutorRequestContext(httpReq *http.RequestContext) *executor.RequestCon
 			Size:      f.Size,
 		}
 	}
+	sessionID := httpReq.SessionID
+	if httpReq.Tenant != "" && sessionID != "" {
+		// Namespace sessions per tenant so one agent serves many customers
+		// without session state bleeding between them.
+		sessionID = httpReq.Tenant + "/" + sessionID
+	}
 	return &executor.RequestContext{
 		Method:    httpReq.Method,
 		Path:      httpReq.Path,
@@ -58,7 +64,11 @@ func toExecutorRequestContext(httpReq *http.RequestContext) *executor.RequestCon
 		Files:     executorFiles,
 		IP:        httpReq.IP,
 		ID:        httpReq.ID,
-		SessionID: httpReq.SessionID,
+		SessionID: sessionID,
+		Tenant:    httpReq.Tenant,
+
+		TargetActionID: httpReq.TargetActionID,
+		Params:         httpReq.Params,
 	}
 }
 
@@ -39,6 +39,7 @@ const (
 	execModeWebServer
 	execModeAPIServer
 	execModeBot
+	execModeWatch
 	execModeFile
 	execModeSingleRun
 )
@@ -31,6 +31,7 @@ import (
 	executorBotReply "github.com/kdeps/kdeps/v2/pkg/executor/botreply"
 	executorBrowser "github.com/kdeps/kdeps/v2/pkg/executor/browser"
 	executorCodeIntelligence "github.com/kdeps/kdeps/v2/pkg/executor/codeintelligence"
+	executorDocs "github.com/kdeps/kdeps/v2/pkg/executor/docs"
 	executorEmail "github.com/kdeps/kdeps/v2/pkg/executor/email"
 	executorEmbedding "github.com/kdeps/kdeps/v2/pkg/executor/embedding"
 	executorExec "github.com/kdeps/kdeps/v2/pkg/executor/exec"
@@ -39,10 +40,14 @@ import (
 	executorHTTP "github.com/kdeps/kdeps/v2/pkg/executor/http"
 	executorLLM "github.com/kdeps/kdeps/v2/pkg/executor/llm"
 	executorLoader "github.com/kdeps/kdeps/v2/pkg/executor/loader"
+	executorPayments "github.com/kdeps/kdeps/v2/pkg/executor/payments"
+	executorPush "github.com/kdeps/kdeps/v2/pkg/executor/push"
 	executorPython "github.com/kdeps/kdeps/v2/pkg/executor/python"
+	executorRerank "github.com/kdeps/kdeps/v2/pkg/executor/rerank"
 	executorScraper "github.com/kdeps/kdeps/v2/pkg/executor/scraper"
 	executorSearchLocal "github.com/kdeps/kdeps/v2/pkg/executor/searchlocal"
 	executorSearchWeb "github.com/kdeps/kdeps/v2/pkg/executor/searchweb"
+	executorSMS "github.com/kdeps/kdeps/v2/pkg/executor/sms"
 	executorSQL "github.com/kdeps/kdeps/v2/pkg/executor/sql"
 	executorTelephony "github.com/kdeps/kdeps/v2/pkg/executor/telephony"
 	executorTranscribe "github.com/kdeps/kdeps/v2/pkg/executor/transcribe"
@@ -115,6 +120,11 @@ func newExecutorRegistry(logger *slog.Logger) *executor.Registry {
 	registry.SetLoaderExecutor(executorLoader.NewAdapter())
 	registry.SetVectorStoreExecutor(executorVectorStore.NewAdapter())
 	registry.SetTranscribeExecutor(executorTranscribe.NewAdapter())
+	registry.SetDocsExecutor(executorDocs.NewAdapter())
+	registry.SetPaymentsExecutor(executorPayments.NewAdapter())
+	registry.SetSMSExecutor(executorSMS.NewAdapter())
+	registry.SetPushExecutor(executorPush.NewAdapter())
+	registry.SetRerankExecutor(executorRerank.NewAdapter())
 	registry.SetLLMExecutor(executorLLM.NewAdapter(getOllamaURL()))
 	return registry
 }
@@ -40,6 +40,9 @@ func executionModeFor(workflow *domain.Workflow) executionMode {
 	if s.Input != nil && s.Input.HasBotSource() {
 		return execModeBot
 	}
+	if s.Input != nil && s.Input.HasWatchSource() {
+		return execModeWatch
+	}
 	if s.Input != nil && s.Input.HasFileSource() {
 		return execModeFile
 	}
@@ -67,6 +70,8 @@ func dispatchExecution(
 		return execBotRunnersFn(workflow, debugMode)
 	case execModeFile:
 		return execFileRunnerFn(workflow, debugMode, fileArg, eventsEnabled)
+	case execModeWatch:
+		return execWatchRunner(workflow, debugMode)
 	case execModeSingleRun:
 		return execSingleRunFn(workflow)
 	}
@@ -43,12 +43,17 @@ const (
 	ollamaCheckInterval  = time.Second
 )
 
-// getOllamaURL returns the configured Ollama base URL from OLLAMA_HOST or the default.
+// getOllamaURL returns the configured Ollama base URL: OLLAMA_HOST when set,
+// the macOS host's Ollama when reachable from inside a container (so Metal
+// acceleration is used instead of CPU inference), or the local default.
 func getOllamaURL() string {
 	kdeps_debug.Log("enter: getOllamaURL")
 	if v := os.Getenv("OLLAMA_HOST"); v != "" {
 		return v
 	}
+	if hostURL := resolveContainerHostOllama(); hostURL != "" {
+		return hostURL
+	}
 	return ollamaDefaultURL
 }
 
@@ -70,6 +75,7 @@ func IsOllamaRunning(host string, port int) bool {
 // startOllamaServer starts the Ollama server in the background.
 func startOllamaServer() error {
 	kdeps_debug.Log("enter: startOllamaServer")
+	logAccelerationDiagnostics()
 	// Check if ollama command exists
 	_, lookErr := execLookPathFunc("ollama")
 	if lookErr != nil {
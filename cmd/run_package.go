@@ -23,19 +23,35 @@ package cmd
 import (
 	"archive/tar"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/kdeps/kdeps/v2/pkg/archive/targz"
 	kdeps_debug "github.com/kdeps/kdeps/v2/pkg/debug"
 	"github.com/kdeps/kdeps/v2/pkg/domain"
+	"github.com/kdeps/kdeps/v2/pkg/executor"
 )
 
 func ExtractPackage(packagePath string) (string, error) {
 	kdeps_debug.Log("enter: ExtractPackage")
-	tempDir, _, err := targz.ExtractToTemp(packagePath, "kdeps-run-*", runPackageExtractOpts())
+	opts := runPackageExtractOpts()
+	lazyData := os.Getenv("KDEPS_LAZY_DATA") == "true"
+	if lazyData {
+		// Huge data/ trees stay in the archive and materialize on first
+		// access (see executor.RegisterLazyDataArchive).
+		opts.SkipEntry = func(name string) bool {
+			return strings.HasPrefix(name, "data/")
+		}
+	}
+	tempDir, _, err := targz.ExtractToTemp(packagePath, "kdeps-run-*", opts)
 	if err != nil {
 		return "", mapRunPackageExtractError(packagePath, err)
 	}
+	if lazyData {
+		executor.RegisterLazyDataArchive(tempDir, packagePath)
+	} else if verifyErr := VerifyIntegrityManifest(tempDir); verifyErr != nil {
+		return "", verifyErr
+	}
 	return tempDir, nil
 }
 
@@ -26,9 +26,12 @@ import (
 
 	kdeps_debug "github.com/kdeps/kdeps/v2/pkg/debug"
 	"github.com/kdeps/kdeps/v2/pkg/domain"
+	"github.com/kdeps/kdeps/v2/pkg/events"
 	"github.com/kdeps/kdeps/v2/pkg/executor"
 	"github.com/kdeps/kdeps/v2/pkg/infra/http"
 	"github.com/kdeps/kdeps/v2/pkg/infra/logging"
+	"github.com/kdeps/kdeps/v2/pkg/infra/queue"
+	kdepslog "github.com/kdeps/kdeps/v2/pkg/log"
 )
 
 func printSingleRunOutput(output interface{}) {
@@ -68,6 +71,35 @@ func createHTTPServerWithEngine(
 		return nil, fmt.Errorf("failed to create HTTP server: %w", err)
 	}
 	httpServer.SetWorkflowPath(workflowPath)
+
+	// Feed per-run progress (GET /runs/{id}/progress) from the engine's
+	// event stream, preserving any emitter already attached (--events).
+	// Timings persist across runs for ETAs, regression events, and
+	// shortest-first scheduling of independent resources.
+	var timingStore *events.TimingStore
+	if timingsPath, pathErr := events.DefaultTimingStorePath(); pathErr == nil {
+		timingStore = events.LoadTimingStore(timingsPath)
+		eng.SetTimingHints(timingStore.Averages())
+	}
+	tracker := events.NewProgressTrackerWithStore(eng.Emitter(), timingStore)
+	eng.SetEmitter(tracker)
+	httpServer.SetProgressTracker(tracker)
+
+	startConnectionHealthMonitor()
+	setupAlertingMonitor()
+	startRetentionJanitor(workflow)
+
+	// Durable run queue (opt-in): saturated requests persist to SQLite and
+	// drain when capacity frees up, instead of failing with 503.
+	if os.Getenv("KDEPS_RUN_QUEUE") == "true" {
+		if queuePath, pathErr := queue.DefaultPath(); pathErr == nil {
+			if runQueue, openErr := queue.Open(queuePath); openErr == nil {
+				httpServer.EnableRunQueue(runQueue)
+			} else {
+				kdepslog.Warn("could not open run queue", "error", openErr)
+			}
+		}
+	}
 	if devMode {
 		setupDevMode(httpServer, workflowPath)
 	}
@@ -198,6 +198,9 @@ func ExecuteWorkflowStepsWithFlags(cmd *cobra.Command, workflowPath string, flag
 	if llmErr := ensureLLMBackendStep(workflow); llmErr != nil {
 		return llmErr
 	}
+	if modelsErr := ensureRequiredModels(workflow); modelsErr != nil {
+		return modelsErr
+	}
 
 	fmt.Fprintln(os.Stdout, "\n[5/5] Starting execution...")
 	if flags.Interactive {
@@ -21,6 +21,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -40,7 +41,7 @@ import (
 // newValidateCmd creates the validate command.
 func newValidateCmd() *cobra.Command {
 	kdeps_debug.Log("enter: newValidateCmd")
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "validate [path]",
 		Short: "Validate YAML configuration",
 		Long: `Validate KDeps workflow, component, or agency against JSON Schema and business rules
@@ -71,19 +72,45 @@ Examples:
 
   # Validate agency directory
   kdeps validate examples/my-agency`,
-		Args: cobra.ExactArgs(1),
+		Args: cobra.MaximumNArgs(1),
 		RunE: RunValidateCmd,
 	}
+	cmd.Flags().StringVar(
+		&validateRemoteURL, "remote", "",
+		"Validate a deployed agent instead: fetch its health/status from this base URL.",
+	)
+	cmd.Flags().BoolVar(
+		&validateStrictMode, "strict", false,
+		"Also type-check expressions against accessor signatures and declared "+
+			"validation rules; unknown functions fail validation.",
+	)
+	return cmd
 }
 
+// validateRemoteURL holds the --remote flag for the validate command.
+//
+//nolint:gochecknoglobals // cobra flag target
+var validateRemoteURL string
+
+// validateStrictMode holds the --strict flag for the validate command.
+//
+//nolint:gochecknoglobals // cobra flag target
+var validateStrictMode bool
+
 // RunValidateCmd is the exported function for running the validate command (used for testing).
 func RunValidateCmd(cmd *cobra.Command, args []string) error {
 	kdeps_debug.Log("enter: RunValidateCmd")
 	return runValidateCmd(cmd, args)
 }
 
-func runValidateCmd(_ *cobra.Command, args []string) error {
+func runValidateCmd(cmd *cobra.Command, args []string) error {
 	kdeps_debug.Log("enter: runValidateCmd")
+	if validateRemoteURL != "" {
+		return fetchRemoteHealth(cmd.OutOrStdout(), validateRemoteURL)
+	}
+	if len(args) != 1 {
+		return errors.New("requires a path (or --remote)")
+	}
 	inputPath := args[0]
 
 	info, err := os.Stat(inputPath)
@@ -210,11 +237,21 @@ func validateWorkflowFile(workflowPath string) error {
 	fmt.Fprintln(os.Stdout, "- Expressions valid")
 	fmt.Fprintln(os.Stdout, "- Static analysis passed")
 
-	// Print analysis warnings (non-fatal).
+	// Print analysis warnings (non-fatal); strict mode adds expression
+	// type checking and fails on its errors.
 	analysis := validator.AnalyzeWorkflow(workflow)
+	if validateStrictMode {
+		analysis.Issues = append(analysis.Issues, validator.TypeCheckExpressions(workflow)...)
+	}
 	for _, w := range analysis.Warnings() {
 		fmt.Fprintf(os.Stdout, "  warning: %s\n", w.String())
 	}
+	if validateStrictMode && analysis.HasErrors() {
+		for _, e := range analysis.Errors() {
+			fmt.Fprintf(os.Stdout, "  error: %s\n", e.String())
+		}
+		return fmt.Errorf("strict validation failed with %d error(s)", len(analysis.Errors()))
+	}
 
 	printValidationDone()
 
@@ -99,7 +99,7 @@ func runMain(config *AppConfig) {
 func RunMainWithConfig(config *AppConfig) int {
 	if err := config.ExecuteCmd(config.Version, config.Commit); err != nil {
 		kdepslog.Error("fatal", "error", err)
-		return 1
+		return cmd.ExitCodeForError(err)
 	}
-	return 0
+	return cmd.ExitSuccess
 }
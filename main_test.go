@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/kdeps/kdeps/v2/cmd"
+	"github.com/kdeps/kdeps/v2/pkg/domain"
 )
 
 // writeEmbeddedBinary writes a fake binary with an embedded-package trailer
@@ -65,6 +66,11 @@ func TestRunMainWithConfig(t *testing.T) {
 	}{
 		{name: "success", mockError: nil, wantExitCode: 0},
 		{name: "error", mockError: errors.New("test error"), wantExitCode: 1},
+		{
+			name:         "validation error maps to exit 2",
+			mockError:    domain.NewAppError(domain.ErrCodeValidation, "bad input"),
+			wantExitCode: 2,
+		},
 	}
 
 	for _, tt := range tests {
@@ -62,6 +62,10 @@ func ExtractTar(tr *tar.Reader, destDir string, opts Options) error {
 			return fmt.Errorf("archive entry count exceeds limit of %d", opts.MaxEntries)
 		}
 
+		if opts.SkipEntry != nil && opts.SkipEntry(hdr.Name) {
+			continue
+		}
+
 		n, err := extractOneEntry(tr, hdr, destDir, opts, hooks, totalExtracted)
 		if err != nil {
 			return err
@@ -39,7 +39,10 @@ type Options struct {
 	AbsPaths     bool
 	AbsDest      bool
 	SkipBadPaths bool
-	Hooks        Hooks
+	// SkipEntry, when set, skips archive entries for which it returns true
+	// (lazy extraction of large subtrees).
+	SkipEntry func(name string) bool
+	Hooks     Hooks
 }
 
 // DefaultOptions returns limits and permissions used by CLI package extraction.
@@ -153,4 +153,7 @@ func applyEnv(cfg Config) {
 	applyLLMEnv(cfg.LLM)
 	applyResourceDefaults(cfg.ResourceDefaults)
 	setIfUnset("KDEPS_API_AUTH_TOKEN", cfg.APIAuthToken)
+	if cfg.Outbound != nil {
+		setIfUnset("KDEPS_USER_AGENT", cfg.Outbound.UserAgent)
+	}
 }
@@ -77,8 +77,8 @@ func LoadStructWithAgent(agentName string) (*Config, error) {
 		return nil, err
 	}
 	if agentName != "" && cfg.Agents != nil {
-		if profile, ok := cfg.Agents[agentName]; ok {
-			mergeConfig(cfg, &profile)
+		if mergeErr := mergeAgentProfileChain(cfg, agentName); mergeErr != nil {
+			return nil, mergeErr
 		}
 	}
 	return cfg, nil
@@ -107,8 +107,8 @@ func LoadWithAgent(agentName string) (*Config, error) {
 		return nil, err
 	}
 	if agentName != "" && cfg.Agents != nil {
-		if profile, ok := cfg.Agents[agentName]; ok {
-			mergeConfig(cfg, &profile)
+		if mergeErr := mergeAgentProfileChain(cfg, agentName); mergeErr != nil {
+			return nil, mergeErr
 		}
 	}
 	// Clear known config env vars before applying so merged agent values take effect.
@@ -172,6 +172,7 @@ func load() (*Config, error) {
 	if err != nil {
 		return nil, fmt.Errorf("read %s: %w", path, err)
 	}
+	data = maybeUpgradeConfig(path, data)
 	var cfg Config
 	if unmarshalErr := yaml.Unmarshal(data, &cfg); unmarshalErr != nil {
 		return nil, fmt.Errorf("parse %s: %w", path, unmarshalErr)
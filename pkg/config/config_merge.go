@@ -18,6 +18,8 @@
 
 package config
 
+import "fmt"
+
 // mergeConfig overlays non-empty fields from src onto dst.
 func mergeConfig(dst *Config, src *Config) {
 	mergeLLMKeys(&dst.LLM, &src.LLM)
@@ -31,7 +33,23 @@ func mergeConfig(dst *Config, src *Config) {
 		dst.BotConnections = src.BotConnections
 	}
 	mergeMap(&dst.SQLConnections, src.SQLConnections)
+	mergeMap(&dst.DocsConnections, src.DocsConnections)
+	mergeMap(&dst.PaymentsConnections, src.PaymentsConnections)
+	mergeMap(&dst.SMSConnections, src.SMSConnections)
+	mergeMap(&dst.PushConnections, src.PushConnections)
 	setStrIfNotEmpty(&dst.APIAuthToken, src.APIAuthToken)
+	setStrIfNotEmpty(&dst.ContainerRuntime, src.ContainerRuntime)
+	if src.Isolation != nil {
+		dst.Isolation = src.Isolation
+	}
+	mergeMap(&dst.Tenants, src.Tenants)
+	if src.Residency != nil {
+		dst.Residency = src.Residency
+	}
+	mergeMap(&dst.Secrets, src.Secrets)
+	if src.Outbound != nil {
+		dst.Outbound = src.Outbound
+	}
 }
 
 // setStrIfNotEmpty copies src to *dst when src is non-empty.
@@ -165,3 +183,52 @@ func mergeMap[M ~map[K]V, K comparable, V any](dst *M, src M) {
 		(*dst)[k] = v
 	}
 }
+
+// mergeAgentProfileChain merges the named agent profile onto cfg, first
+// resolving its extends chain: the most-base profile merges first, then each
+// descendant, then the agent's own profile — so descendants override their
+// bases and connection maps deep-merge along the way. Cycles and missing
+// bases are errors.
+func mergeAgentProfileChain(cfg *Config, agentName string) error {
+	chain, err := resolveProfileChain(cfg.Agents, agentName)
+	if err != nil {
+		return err
+	}
+	for _, name := range chain {
+		profile := cfg.Agents[name]
+		mergeConfig(cfg, &profile)
+	}
+	return nil
+}
+
+// resolveProfileChain returns profile names base-first for the agent.
+func resolveProfileChain(agents map[string]Config, agentName string) ([]string, error) {
+	profile, ok := agents[agentName]
+	if !ok {
+		return nil, nil // no profile for this agent — nothing to merge
+	}
+
+	var chain []string
+	seen := map[string]bool{}
+	name := agentName
+	for {
+		if seen[name] {
+			return nil, fmt.Errorf(
+				"agent profile %q: extends cycle involving %q", agentName, name,
+			)
+		}
+		seen[name] = true
+		chain = append([]string{name}, chain...)
+		if profile.Extends == "" {
+			return chain, nil
+		}
+		base, baseOK := agents[profile.Extends]
+		if !baseOK {
+			return nil, fmt.Errorf(
+				"agent profile %q extends unknown profile %q", name, profile.Extends,
+			)
+		}
+		name = profile.Extends
+		profile = base
+	}
+}
@@ -181,6 +181,35 @@ defaults:
 #   local:
 #     connection: "sqlite3://./dev.db"
 
+# ── Named docs connections — Notion / Confluence page publishing ─────────────
+# docs_connections:
+#   team-notion:
+#     provider: "notion"
+#     token: "${NOTION_TOKEN}"
+#   team-wiki:
+#     provider: "confluence"
+#     baseURL: "https://example.atlassian.net/wiki"
+#     username: "bot@example.com"
+#     token: "${CONFLUENCE_API_TOKEN}"
+
+# ── Named payments connections — Stripe-compatible APIs ──────────────────────
+# payments_connections:
+#   stripe-live:
+#     secretKey: "${STRIPE_SECRET_KEY}"
+#     webhookSecret: "${STRIPE_WEBHOOK_SECRET}"
+
+# ── Named SMS connections — Twilio-compatible messaging ──────────────────────
+# sms_connections:
+#   twilio-main:
+#     accountSid: "${TWILIO_ACCOUNT_SID}"
+#     authToken: "${TWILIO_AUTH_TOKEN}"
+#     from: "+15550001111"
+
+# ── Named push connections — FCM-compatible notifications ────────────────────
+# push_connections:
+#   fcm-main:
+#     serverKey: "${FCM_SERVER_KEY}"
+
 # ── API server auth token ─────────────────────────────────────────────────────
 # Bearer token required on all requests to the agent HTTP server.
 # Required when apiServer is enabled. Set here or via KDEPS_API_AUTH_TOKEN env var.
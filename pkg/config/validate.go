@@ -29,6 +29,7 @@ const (
 //nolint:gochecknoglobals // read-only lookup tables for validation
 var (
 	knownTopLevelKeys = map[string]bool{
+		"version":           true,
 		"llm":               true,
 		"defaults":          true,
 		"resource_defaults": true,
@@ -130,6 +131,14 @@ var (
 			name: "watsonx", yamlKey: "watsonx_api_key", envVar: "WATSONX_API_KEY",
 			field: func(k *LLMKeys) *string { return &k.WatsonX },
 		},
+		{
+			name: "azure", yamlKey: "azure_openai_api_key", envVar: "AZURE_OPENAI_API_KEY",
+			field: func(k *LLMKeys) *string { return &k.AzureOpenAI },
+		},
+		{
+			name: "vertex", yamlKey: "vertex_api_token", envVar: "VERTEX_API_TOKEN",
+			field: func(k *LLMKeys) *string { return &k.Vertex },
+		},
 	}
 
 	cloudProviders   = buildCloudProviderMap(cloudProvidersList)
@@ -28,6 +28,13 @@ import (
 func (c *Config) validateValues() []string {
 	var warnings []string
 
+	if c.Version > CurrentConfigVersion {
+		warnings = append(warnings, fmt.Sprintf(
+			"config version %d is newer than this kdeps supports (%d) — "+
+				"some settings may be ignored; upgrade kdeps",
+			c.Version, CurrentConfigVersion))
+	}
+
 	// Strategy must be a known value.
 	if !validStrategies[c.LLM.Strategy] {
 		valid := []string{
@@ -33,6 +33,12 @@ type Resource struct {
 	Items       []string `yaml:"items,omitempty"`
 
 	// Cross-cutting execution fields
+	// Region tags the data this resource handles for residency enforcement
+	// (see the residency block in ~/.kdeps/config.yaml).
+	Region string      `yaml:"region,omitempty"`
+	Lock   *LockConfig `yaml:"lock,omitempty"`
+	Once   string      `yaml:"once,omitempty"` // exactly-once guard key expression; repeats return the stored output
+
 	Tool        string             `yaml:"tool,omitempty"        json:"tool,omitempty"`
 	Validations *ValidationsConfig `yaml:"validations,omitempty"`
 	Loop        *LoopConfig        `yaml:"loop,omitempty"`
@@ -63,6 +69,13 @@ type Resource struct {
 	Loader           *LoaderConfig           `yaml:"loader,omitempty"`
 	VectorStore      *VectorStoreConfig      `yaml:"vectorStore,omitempty"`
 	Transcribe       *TranscribeConfig       `yaml:"transcribe,omitempty"`
+	Docs             *DocsConfig             `yaml:"docs,omitempty"`
+	Rerank           *RerankConfig           `yaml:"rerank,omitempty"`
+	Push             *PushConfig             `yaml:"push,omitempty"`
+	SMS              *SMSConfig              `yaml:"sms,omitempty"`
+	Payments         *PaymentsConfig         `yaml:"payments,omitempty"`
+	Orchestrate      *OrchestrateConfig      `yaml:"orchestrate,omitempty"`
+	Plugin           *PluginCallConfig       `yaml:"plugin,omitempty"`
 }
 
 // LoopConfig configures while-loop repetition for a resource, enabling Turing-complete
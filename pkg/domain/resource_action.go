@@ -47,6 +47,13 @@ type ActionConfig struct {
 	Loader           *LoaderConfig           `yaml:"loader,omitempty"`
 	VectorStore      *VectorStoreConfig      `yaml:"vectorStore,omitempty"`
 	Transcribe       *TranscribeConfig       `yaml:"transcribe,omitempty"`
+	Docs             *DocsConfig             `yaml:"docs,omitempty"`
+	Rerank           *RerankConfig           `yaml:"rerank,omitempty"`
+	Push             *PushConfig             `yaml:"push,omitempty"`
+	SMS              *SMSConfig              `yaml:"sms,omitempty"`
+	Payments         *PaymentsConfig         `yaml:"payments,omitempty"`
+	Orchestrate      *OrchestrateConfig      `yaml:"orchestrate,omitempty"`
+	Plugin           *PluginCallConfig       `yaml:"plugin,omitempty"`
 	APIResponse      *APIResponseConfig      `yaml:"apiResponse,omitempty"`
 }
 
@@ -25,6 +25,12 @@ type APIResponseConfig struct {
 	StatusCode int               `yaml:"statusCode,omitempty"` // HTTP status code for the response
 	Model      string            `yaml:"model,omitempty"`
 	Backend    string            `yaml:"backend,omitempty"`
+
+	// Transform lists post-processing steps applied to the evaluated
+	// response data, in order. Named transformers: camelCaseKeys,
+	// snakeCaseKeys, stripNulls, flatten. Entries of the form
+	// "mapField: old=new" rename a top-level field.
+	Transform []string `yaml:"transform,omitempty"`
 }
 
 // AgentCallConfig configures a call to a sibling agent within the same agency.
@@ -36,11 +36,17 @@ const (
 
 // ThinkingConfig controls extended reasoning/thinking for models that support it.
 type ThinkingConfig struct {
-	Mode               ThinkingMode `yaml:"mode,omitempty"`               // none | low | medium | high | auto
-	BudgetTokens       int          `yaml:"budgetTokens,omitempty"`       // explicit token budget (overrides Mode)
-	ReturnOutput       bool         `yaml:"returnOutput,omitempty"`       // include thinking text in action output
-	StreamThinking     bool         `yaml:"streamThinking,omitempty"`     // stream reasoning tokens in real-time via StreamingFunc
-	InterleaveThinking bool         `yaml:"interleaveThinking,omitempty"` // interleave thinking between tool calls (Anthropic)
+	Mode         ThinkingMode `yaml:"mode,omitempty"`         // none | low | medium | high | auto
+	BudgetTokens int          `yaml:"budgetTokens,omitempty"` // explicit token budget (overrides Mode)
+	ReturnOutput bool         `yaml:"returnOutput,omitempty"` // include thinking text in action output
+	// Capture controls what happens to reasoning traces in the response
+	// content: "strip" removes them, "runHistory" moves them to the
+	// response's thinking field (visible in recorded runs but never in API
+	// responses unless explicitly mapped), "debug" strips them after
+	// writing to the debug log. Empty keeps today's behavior.
+	Capture            string `yaml:"capture,omitempty"`
+	StreamThinking     bool   `yaml:"streamThinking,omitempty"`     // stream reasoning tokens in real-time via StreamingFunc
+	InterleaveThinking bool   `yaml:"interleaveThinking,omitempty"` // interleave thinking between tool calls (Anthropic)
 	// ThinkingWriter, when set and StreamThinking is true, receives raw reasoning
 	// chunks directly instead of the default content writer. Used by the REPL to
 	// display thinking in real-time without mixing it with the buffered content stream.
@@ -248,6 +254,49 @@ type ChatConfig struct {
 	N                 *int     `yaml:"n,omitempty"`                 // Number of completions to generate (OpenAI)
 	MinLength         *int     `yaml:"minLength,omitempty"`         // Minimum generation length (local/HuggingFace)
 	MaxLength         *int     `yaml:"maxLength,omitempty"`         // Maximum generation length (local/HuggingFace)
+
+	// Experiment splits traffic across named prompt/model/temperature variants
+	// for A/B testing. The chosen variant is exposed in _meta.variant.
+	Experiment *ExperimentConfig `yaml:"experiment,omitempty"`
+
+	// Critique adds a self-check pass: a critic model scores the draft against
+	// criteria and accepts, revises (bounded), or fails the resource.
+	Critique *CritiqueConfig `yaml:"critique,omitempty"`
+
+	// Environments overrides sampling parameters per named environment
+	// (selected by KDEPS_ENV), so tests and evals can pin seed/temperature
+	// without touching the production config.
+	Environments map[string]ChatEnvironmentOverride `yaml:"environments,omitempty"`
+
+	// ContextBudget trims the request (truncate, summarize, drop tool
+	// schemas) when it would exceed the model's context window.
+	ContextBudget *ContextBudgetConfig `yaml:"contextBudget,omitempty"`
+
+	// Citations injects numbered sources into the prompt and maps [n]
+	// markers in the answer back to source metadata.
+	Citations *CitationsConfig `yaml:"citations,omitempty"`
+
+	// StickyModel pins a session to the model that served its first request,
+	// so multi-turn conversations keep one persona when fallback chains or
+	// experiments are active.
+	StickyModel bool `yaml:"stickyModel,omitempty"`
+
+	// Degradation is the policy applied when the local LLM backend is
+	// unreachable: answer with a canned response, queue the request for
+	// later, or fail immediately with 503 and a retry hint — instead of
+	// every request timing out at full duration.
+	Degradation *LLMDegradationConfig `yaml:"degradation,omitempty"`
+}
+
+// LLMDegradationConfig configures graceful degradation when the LLM backend
+// is down.
+type LLMDegradationConfig struct {
+	// Mode is canned, queue, or unavailable (default).
+	Mode string `yaml:"mode,omitempty"`
+	// Response is the canned reply text (canned mode).
+	Response string `yaml:"response,omitempty"`
+	// RetryAfterSeconds is the retry hint on 503 responses (default 30).
+	RetryAfterSeconds int `yaml:"retryAfterSeconds,omitempty"`
 }
 
 // ScenarioItem represents a chat scenario item.
@@ -279,14 +328,23 @@ type MCPConfig struct {
 
 // Tool represents an LLM tool.
 type Tool struct {
-	Name        string               `yaml:"name"`
-	Script      string               `yaml:"script,omitempty"` // kdeps resource actionId; omit when using MCP
-	MCP         *MCPConfig           `yaml:"mcp,omitempty"`    // MCP server config (alternative to script)
+	Name   string     `yaml:"name"`
+	Script string     `yaml:"script,omitempty"` // kdeps resource actionId; omit when using MCP
+	MCP    *MCPConfig `yaml:"mcp,omitempty"`    // MCP server config (alternative to script)
+	// Resource references a workflow resource by actionId: the tool's JSON
+	// schema derives from that resource's validation rules and calls route
+	// through the resource — tools and resources stay one definition.
+	Resource    string               `yaml:"resource,omitempty"`
 	Description string               `yaml:"description"`
 	Parameters  map[string]ToolParam `yaml:"parameters"`
 	// Strict, when true, tells the provider to enforce the parameter schema strictly.
 	// Provider support varies; typically used for structured output guarantees (OpenAI).
 	Strict bool `yaml:"strict,omitempty"`
+	// Memoize serves repeated calls with identical arguments from a
+	// per-session cache — cutting latency and duplicate side effects when a
+	// model re-asks the same question within a conversation. Opt-in because
+	// it is wrong for tools whose results change between calls.
+	Memoize bool `yaml:"memoize,omitempty"`
 	// Execute is a runtime-only direct dispatch function set by agent mode.
 	// When non-nil it takes priority over Script and MCP. Never serialized.
 	Execute func(args map[string]any) (string, error) `yaml:"-" json:"-"`
@@ -38,6 +38,31 @@ type HTTPClientConfig struct {
 	// FollowRedirects: nil (default) = follow redirects, false = don't follow, true = follow
 	FollowRedirects *bool          `yaml:"followRedirects,omitempty"`
 	TLS             *HTTPTLSConfig `yaml:"tls,omitempty"`
+	// MaxRedirects caps how many redirects are followed (nil = Go's default
+	// of 10). Exceeding the cap fails the request.
+	MaxRedirects *int `yaml:"maxRedirects,omitempty"`
+	// Proxy selects an HTTP or SOCKS5 proxy for this resource
+	// (e.g. "socks5://localhost:1080"), overriding the named connection's
+	// proxy and the environment.
+	Proxy string `yaml:"proxy,omitempty"`
+	// MaxResponseBytes caps the (decompressed) response body for this
+	// resource, overriding KDEPS_HTTP_MAX_RESPONSE_BYTES. Oversized
+	// responses fail with ErrResponseTooLarge.
+	MaxResponseBytes int64 `yaml:"maxResponseBytes,omitempty"`
+	// AcceptContentTypes lists acceptable response media types (e.g.
+	// ["application/json"]). A response outside the list fails with
+	// ErrUnexpectedContentType.
+	AcceptContentTypes []string `yaml:"acceptContentTypes,omitempty"`
+	// ParseAs overrides response parsing: json, xml, csv, ndjson, or text
+	// (no parsing). Empty negotiates from the response content type.
+	ParseAs string `yaml:"parseAs,omitempty"`
+	// UseCookies attaches the run's shared cookie jar, so Set-Cookie from
+	// earlier requests (CSRF fetch, login) flows into later ones without
+	// threading headers through expressions.
+	UseCookies bool `yaml:"useCookies,omitempty"`
+	// Login names an httpClient resource that must run first (an implicit
+	// requires edge) to establish the cookie session. Implies UseCookies.
+	Login string `yaml:"login,omitempty"`
 }
 
 // RetryConfig represents retry configuration.
@@ -99,6 +124,11 @@ type ExecConfig struct {
 	Timeout    string            `yaml:"timeout,omitempty"`
 	WorkingDir string            `yaml:"workingDir,omitempty"` // Working directory for command execution
 	Env        map[string]string `yaml:"env,omitempty"`        // Environment variables
+
+	// Shell selects the interpreter for Command when Args is empty:
+	// sh, bash, cmd, powershell, or pwsh. Empty uses the platform default
+	// (sh on POSIX, cmd on Windows).
+	Shell string `yaml:"shell,omitempty"`
 }
 
 // APIResponseConfig represents API response configuration.
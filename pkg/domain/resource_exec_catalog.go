@@ -117,6 +117,27 @@ var resourceExecCatalog = []ResourceExecCatalogEntry{
 	catalogEntry("transcribe",
 		func(r *Resource) bool { return r.Transcribe != nil },
 		func(a *ActionConfig) bool { return a.Transcribe != nil }),
+	catalogEntry("docs",
+		func(r *Resource) bool { return r.Docs != nil },
+		func(a *ActionConfig) bool { return a.Docs != nil }),
+	catalogEntry("payments",
+		func(r *Resource) bool { return r.Payments != nil },
+		func(a *ActionConfig) bool { return a.Payments != nil }),
+	catalogEntry("sms",
+		func(r *Resource) bool { return r.SMS != nil },
+		func(a *ActionConfig) bool { return a.SMS != nil }),
+	catalogEntry("push",
+		func(r *Resource) bool { return r.Push != nil },
+		func(a *ActionConfig) bool { return a.Push != nil }),
+	catalogEntry("rerank",
+		func(r *Resource) bool { return r.Rerank != nil },
+		func(a *ActionConfig) bool { return a.Rerank != nil }),
+	catalogEntry("orchestrate",
+		func(r *Resource) bool { return r.Orchestrate != nil },
+		func(a *ActionConfig) bool { return a.Orchestrate != nil }),
+	catalogEntry("plugin",
+		func(r *Resource) bool { return r.Plugin != nil },
+		func(a *ActionConfig) bool { return a.Plugin != nil }),
 	catalogResponseEntry("apiResponse",
 		func(r *Resource) bool { return r.APIResponse != nil },
 		func(a *ActionConfig) bool { return a.APIResponse != nil }),
@@ -41,7 +41,7 @@ func TestResourceExecCatalog_DerivesPrimaryAndInline(t *testing.T) {
 		"chat", "httpClient", "sql", "python", "exec", "agent", "component",
 		"scraper", "embedding", "searchLocal", "searchWeb",
 		"telephony", "browser", "botReply", "email", "file", "git",
-		"codeIntelligence", "loader", "vectorStore", "transcribe", "apiResponse",
+		"codeIntelligence", "loader", "vectorStore", "transcribe", "docs", "payments", "sms", "push", "rerank", "orchestrate", "plugin", "apiResponse",
 	}
 	if len(inlineNames) != len(wantInline) {
 		t.Fatalf("inline count %d, want %d", len(inlineNames), len(wantInline))
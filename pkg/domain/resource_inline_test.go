@@ -52,7 +52,7 @@ func TestInlineResourceTypeNames_MatchesRegistry(t *testing.T) {
 		"chat", "httpClient", "sql", "python", "exec", "agent", "component",
 		"scraper", "embedding", "searchLocal", "searchWeb",
 		"telephony", "browser", "botReply", "email", "file", "git",
-		"codeIntelligence", "loader", "vectorStore", "transcribe", "apiResponse",
+		"codeIntelligence", "loader", "vectorStore", "transcribe", "docs", "payments", "sms", "push", "rerank", "orchestrate", "plugin", "apiResponse",
 	}
 	got := InlineResourceTypeNames()
 	if len(got) != len(want) {
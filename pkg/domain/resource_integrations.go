@@ -46,6 +46,20 @@ type EmbeddingConfig struct {
 	RerankQuery     string   `yaml:"rerankQuery,omitempty"`     // query string to rank documents against
 	RerankDocuments []string `yaml:"rerankDocuments,omitempty"` // candidate documents to rerank
 	RerankTopN      int      `yaml:"rerankTopN,omitempty"`      // number of top results to return (0 = all)
+
+	// Cache controls the persistent content-hash cache for vectorize and
+	// embed_query: vectors are stored in SQLite keyed by content hash + model,
+	// so re-indexing unchanged documents is free. Enabled by default; set to
+	// false to disable.
+	Cache *bool `yaml:"cache,omitempty"`
+	// Reindex bypasses cache reads (results are still written back), forcing
+	// vectors to be recomputed. Also settable via kdeps run --reindex.
+	Reindex bool `yaml:"reindex,omitempty"`
+
+	// BatchWindow enables micro-batching for embed_query: concurrent calls
+	// against the same model within this window (e.g. "20ms") merge into one
+	// backend request. Empty disables batching.
+	BatchWindow string `yaml:"batchWindow,omitempty"`
 }
 
 // LoaderConfig represents a document loader configuration.
@@ -28,6 +28,8 @@ const (
 	InputSourceBot = "bot"
 	// InputSourceFile is the input source for file content read from stdin or a file path.
 	InputSourceFile = "file"
+	// InputSourceWatch is the input source for filesystem watch events (drop-folder automation).
+	InputSourceWatch = "watch"
 	// BotExecutionTypePolling is the default long-running polling/WebSocket execution mode.
 	BotExecutionTypePolling = "polling"
 	// BotExecutionTypeStateless is a single-shot execution: reads a message from stdin (JSON),
@@ -47,7 +49,19 @@ type Workflow struct {
 	Metadata   WorkflowMetadata `yaml:"metadata"`
 	Settings   WorkflowSettings `yaml:"settings"`
 	Resources  []*Resource      `yaml:"resources,omitempty"` // Can be inline or loaded from resources/ directory.
-	Tests      []TestCase       `yaml:"tests,omitempty"`     // Inline self-test cases run with --self-test.
+
+	// Constants are workflow-level values resolved once at load time and
+	// referenced throughout resources as const.NAME. String values may use
+	// {{ }} expressions (evaluated without request context); defs is an
+	// accepted alias, with constants winning on key collisions.
+	Constants map[string]interface{} `yaml:"constants,omitempty"`
+	Defs      map[string]interface{} `yaml:"defs,omitempty"`
+
+	// Include lists additional resource files, directories, or glob
+	// patterns (relative to the workflow) merged in declaration order.
+	Include []string `yaml:"include,omitempty"`
+
+	Tests []TestCase `yaml:"tests,omitempty"` // Inline self-test cases run with --self-test.
 
 	// Components maps component name -> parsed Component definition.
 	// Populated by the parser when loading components alongside the workflow.
@@ -126,6 +140,7 @@ type WorkflowMetadata struct {
 type WorkflowSettings struct {
 	CertFile       string                   `yaml:"certFile,omitempty"`
 	KeyFile        string                   `yaml:"keyFile,omitempty"`
+	TLS            *TLSSettings             `yaml:"tls,omitempty"`
 	APIServer      *APIServerConfig         `yaml:"apiServer,omitempty"`
 	WebServer      *WebServerConfig         `yaml:"webServer,omitempty"`
 	AgentSettings  AgentSettings            `yaml:"agentSettings"`
@@ -134,6 +149,34 @@ type WorkflowSettings struct {
 	WebApp         *WebAppConfig            `yaml:"webApp,omitempty"         json:"webApp,omitempty"`
 	Input          *InputConfig             `yaml:"input,omitempty"          json:"input,omitempty"`
 	LLM            *LLMInputConfig          `yaml:"llm,omitempty"            json:"llm,omitempty"`
+	Env            *EnvAllowlistConfig      `yaml:"env,omitempty"            json:"env,omitempty"`
+	Retention      *RetentionConfig         `yaml:"retention,omitempty"      json:"retention,omitempty"`
+}
+
+// EnvAllowlistConfig declares which host environment variables the
+// workflow's subprocess executors (exec, python) may see. When set,
+// subprocesses receive only this sanitized set instead of inheriting the
+// full host environment.
+//
+// Example:
+//
+//	settings:
+//	  env:
+//	    allow: [HOME, PATH, AWS_REGION]
+//	    rename:
+//	      PROD_DB_URL: DATABASE_URL
+//	    defaults:
+//	      LOG_LEVEL: info
+type EnvAllowlistConfig struct {
+	// Allow lists host variable names passed through unchanged.
+	Allow []string `yaml:"allow,omitempty"`
+
+	// Rename maps host variable names to the name the subprocess sees.
+	Rename map[string]string `yaml:"rename,omitempty"`
+
+	// Defaults provides values for variables the host does not set
+	// (host values win when present via Allow/Rename).
+	Defaults map[string]string `yaml:"defaults,omitempty"`
 }
 
 // WebAppConfig contains WASM web application configuration.
@@ -146,11 +189,26 @@ type WebAppConfig struct {
 }
 
 // InputConfig specifies the input sources for the workflow.
-// Valid sources: "api" (default), "bot", "file".
+// Valid sources: "api" (default), "bot", "file", "watch".
 type InputConfig struct {
-	Sources []string    `yaml:"sources"        json:"sources"`
-	Bot     *BotConfig  `yaml:"bot,omitempty"  json:"bot,omitempty"`
-	File    *FileConfig `yaml:"file,omitempty" json:"file,omitempty"`
+	Sources []string          `yaml:"sources"         json:"sources"`
+	Bot     *BotConfig        `yaml:"bot,omitempty"   json:"bot,omitempty"`
+	File    *FileConfig       `yaml:"file,omitempty"  json:"file,omitempty"`
+	Watch   *WatchInputConfig `yaml:"watch,omitempty" json:"watch,omitempty"`
+}
+
+// WatchInputConfig configures the watch input source: drop-folder
+// automation where each matching file event starts a run with the file
+// mapped into the request.
+type WatchInputConfig struct {
+	// Paths are directories or glob patterns to monitor
+	// (e.g. "./inbox/*.pdf").
+	Paths []string `yaml:"paths"           json:"paths"`
+	// Events filters which filesystem events trigger a run: create,
+	// write, remove, rename. Empty means create and write.
+	Events []string `yaml:"events,omitempty" json:"events,omitempty"`
+	// Debounce coalesces rapid events on the same file (default "500ms").
+	Debounce string `yaml:"debounce,omitempty" json:"debounce,omitempty"`
 }
 
 // HasSource reports whether the given source is in the Sources list.
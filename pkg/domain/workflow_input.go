@@ -37,6 +37,12 @@ func (c *InputConfig) HasBotSource() bool {
 	return c.HasSource(InputSourceBot)
 }
 
+// HasWatchSource reports whether "watch" is in the Sources list.
+func (c *InputConfig) HasWatchSource() bool {
+	kdeps_debug.Log("enter: HasWatchSource")
+	return c.HasSource(InputSourceWatch)
+}
+
 // HasFileSource reports whether "file" is in the Sources list.
 func (c *InputConfig) HasFileSource() bool {
 	kdeps_debug.Log("enter: HasFileSource")
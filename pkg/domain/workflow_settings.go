@@ -190,6 +190,93 @@ type APIServerConfig struct {
 	RateLimit      *RateLimitConfig `yaml:"rateLimit,omitempty"`
 	MaxBodyBytes   int64            `yaml:"maxBodyBytes,omitempty"`
 	MaxConcurrent  int              `yaml:"maxConcurrent,omitempty"`
+	// DefaultResponseFormat is served when the Accept header doesn't pick
+	// one of json, yaml, xml, csv, or msgpack (default json).
+	DefaultResponseFormat string `yaml:"defaultResponseFormat,omitempty"`
+	// DisableFieldSelection turns off ?fields= response projection.
+	DisableFieldSelection bool `yaml:"disableFieldSelection,omitempty"`
+	// Compression tunes transparent response compression (on by default).
+	Compression *CompressionConfig `yaml:"compression,omitempty"`
+	// UnixSocket serves the API on a Unix domain socket instead of a TCP
+	// port. A socket inherited via systemd activation takes precedence.
+	UnixSocket string `yaml:"unixSocket,omitempty"`
+	// UnixSocketMode sets the socket file permissions, octal (default 0660).
+	UnixSocketMode string `yaml:"unixSocketMode,omitempty"`
+	// Mirror sends a copy of incoming requests to a shadow workflow
+	// version, recording (not serving) its results.
+	Mirror *MirrorConfig `yaml:"mirror,omitempty"`
+	// Capture stores sanitized request/response pairs for a sampled
+	// fraction of traffic (support/debugging; off unless configured).
+	Capture *CaptureConfig `yaml:"capture,omitempty"`
+}
+
+// CaptureConfig opts into replayable request capture.
+type CaptureConfig struct {
+	// SampleRate captures only this fraction of requests (0 or 1 = all).
+	SampleRate float64 `yaml:"sampleRate,omitempty"`
+	// Dir overrides the storage directory
+	// (default ~/.kdeps/captures/<workflow>).
+	Dir string `yaml:"dir,omitempty"`
+	// MaxCaptures stops capturing once this many files exist (default 200).
+	MaxCaptures int `yaml:"maxCaptures,omitempty"`
+}
+
+// MirrorConfig configures shadow traffic to a second workflow version.
+type MirrorConfig struct {
+	// Version names a workflow loaded via multi-version serving.
+	Version string `yaml:"version"`
+	// SampleRate mirrors only this fraction of requests (0 or 1 = all).
+	SampleRate float64 `yaml:"sampleRate,omitempty"`
+	// RecordPath overrides the JSONL sink
+	// (default ~/.kdeps/mirror/<version>.jsonl).
+	RecordPath string `yaml:"recordPath,omitempty"`
+}
+
+// CompressionConfig tunes transparent response compression.
+type CompressionConfig struct {
+	// Disabled turns compression off entirely.
+	Disabled bool `yaml:"disabled,omitempty"`
+	// MinBytes is the smallest body worth compressing (default 1024).
+	MinBytes int `yaml:"minBytes,omitempty"`
+	// DisabledRoutes lists route patterns served uncompressed.
+	DisabledRoutes []string `yaml:"disabledRoutes,omitempty"`
+}
+
+// TLSSettings terminates TLS in the built-in server: static cert/key
+// paths, or ACME auto-provisioning for the configured domains. HTTP/2 is
+// negotiated automatically over TLS; h2c opts cleartext listeners into
+// HTTP/2 (only safe behind an h2c-aware load balancer).
+type TLSSettings struct {
+	CertFile string      `yaml:"certFile,omitempty"`
+	KeyFile  string      `yaml:"keyFile,omitempty"`
+	ACME     *ACMEConfig `yaml:"acme,omitempty"`
+	H2C      bool        `yaml:"h2c,omitempty"`
+}
+
+// ACMEConfig provisions certificates automatically (Let's Encrypt).
+type ACMEConfig struct {
+	// Domains the certificate covers; requests for other hosts are refused.
+	Domains []string `yaml:"domains"`
+	// Email receives expiry and policy notices from the CA.
+	Email string `yaml:"email,omitempty"`
+	// CacheDir stores issued certificates (default ~/.kdeps/autocert).
+	CacheDir string `yaml:"cacheDir,omitempty"`
+}
+
+// RetentionConfig declares how long each data category lives; the
+// background janitor purges anything older. Durations use Go syntax
+// ("720h" = 30 days); empty means keep forever.
+type RetentionConfig struct {
+	// RunHistory ages out recorded runs (~/.kdeps/runs).
+	RunHistory string `yaml:"runHistory,omitempty" json:"runHistory,omitempty"`
+	// Artifacts ages out unreferenced content-store artifacts.
+	Artifacts string `yaml:"artifacts,omitempty" json:"artifacts,omitempty"`
+	// Captures ages out stored request captures.
+	Captures string `yaml:"captures,omitempty" json:"captures,omitempty"`
+	// Sessions ages out session rows by last access.
+	Sessions string `yaml:"sessions,omitempty" json:"sessions,omitempty"`
+	// Interval is the janitor sweep cadence (default "1h").
+	Interval string `yaml:"interval,omitempty" json:"interval,omitempty"`
 }
 
 // Route represents an API route.
@@ -201,6 +288,18 @@ type Route struct {
 	// (anything shipped in JS is public), so demo/same-app endpoints opt
 	// out explicitly instead.
 	Public bool `yaml:"public,omitempty"`
+	// TargetActionID overrides metadata.targetActionId for requests hitting
+	// this route, so one agent exposes multiple logical endpoints ending at
+	// different response nodes.
+	TargetActionID string `yaml:"targetActionId,omitempty"`
+	// Deprecated marks the route for retirement: responses carry
+	// Deprecation (and Sunset/Link when configured) headers and usage is
+	// logged so operators can track remaining consumers.
+	Deprecated bool `yaml:"deprecated,omitempty"`
+	// Sunset is the planned removal date (RFC 3339 date or date-time).
+	Sunset string `yaml:"sunset,omitempty"`
+	// SuccessorLink points consumers at the replacement endpoint.
+	SuccessorLink string `yaml:"successorLink,omitempty"`
 }
 
 // CORS represents CORS configuration.
@@ -274,6 +373,25 @@ type AgentSettings struct {
 	// Versions pins packages in generated Docker images. Empty or "latest" resolves
 	// to the newest GitHub release at bundle build time; explicit semvers are kept.
 	Versions *PackageVersions `yaml:"versions,omitempty"`
+	// DataPrefetch lists data/ paths (or prefixes) to materialize up front
+	// when the package runs with lazy data extraction (KDEPS_LAZY_DATA).
+	DataPrefetch []string `yaml:"dataPrefetch,omitempty"`
+	// RequiredModels declares the models this workflow needs. On startup
+	// kdeps pulls missing Ollama models (verifying the digest when pinned),
+	// records the resolved digests in run metadata, and fails fast when a
+	// model cannot be provided.
+	RequiredModels []RequiredModel `yaml:"requiredModels,omitempty"`
+}
+
+// RequiredModel pins one model a workflow depends on.
+type RequiredModel struct {
+	// Name is the model reference, e.g. "llama3.2:3b".
+	Name string `yaml:"name"`
+	// Digest optionally pins the exact version (sha256:... as reported by
+	// the backend). A present model with a different digest fails startup.
+	Digest string `yaml:"digest,omitempty"`
+	// Backend defaults to "ollama"; other backends are presence-checked only.
+	Backend string `yaml:"backend,omitempty"`
 }
 
 // PackageVersions pins downloaded package versions in generated Docker images.
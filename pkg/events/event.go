@@ -40,6 +40,15 @@ const (
 	EventResourceFailed EventName = "resource.failed"
 	// EventResourceRetrying fires when a resource is about to be retried.
 	EventResourceRetrying EventName = "resource.retrying"
+	// EventResourceFallback fires when a resource error is replaced by its
+	// configured fallback value.
+	EventResourceFallback EventName = "resource.fallback"
+	// EventResourceTimingRegressed fires when a resource ran significantly
+	// slower than its historical average.
+	EventResourceTimingRegressed EventName = "resource.timing_regressed"
+	// EventResourceStalled fires when a resource exceeds its stall
+	// threshold without completing.
+	EventResourceStalled EventName = "resource.stalled"
 )
 
 // FailureClass classifies why a resource or workflow failed.
@@ -113,6 +122,14 @@ func WorkflowStarted(workflowID string) Event {
 	return workflowEvent(EventWorkflowStarted, workflowID)
 }
 
+// WorkflowStartedWithTotal returns a workflow.started event carrying the
+// total resource count, which progress consumers use to compute completion.
+func WorkflowStartedWithTotal(workflowID string, totalResources int) Event {
+	ev := workflowEvent(EventWorkflowStarted, workflowID)
+	ev.Data = map[string]interface{}{"totalResources": totalResources}
+	return ev
+}
+
 // WorkflowCompleted returns a workflow.completed event.
 func WorkflowCompleted(workflowID string) Event {
 	return workflowEvent(EventWorkflowCompleted, workflowID)
@@ -142,3 +159,28 @@ func ResourceCompleted(workflowID, actionID, resourceType string) Event {
 func ResourceFailed(workflowID, actionID, resourceType string, err error) Event {
 	return failedEvent(EventResourceFailed, workflowID, actionID, resourceType, err)
 }
+
+// ResourceRetrying returns a resource.retrying event carrying the attempt
+// counters consumers need to render retry progress.
+func ResourceRetrying(workflowID, actionID string, attempt, maxRetries int) Event {
+	ev := resourceEvent(EventResourceRetrying, workflowID, actionID, "")
+	ev.Data = map[string]interface{}{"attempt": attempt, "maxRetries": maxRetries}
+	return ev
+}
+
+// ResourceFallback returns a resource.fallback event recording the error
+// the fallback value replaced.
+func ResourceFallback(workflowID, actionID string, err error) Event {
+	ev := resourceEvent(EventResourceFallback, workflowID, actionID, "")
+	ev.FailureClass = ClassifyError(err)
+	ev.Detail = err.Error()
+	return ev
+}
+
+// ResourceStalled returns a resource.stalled event with detail describing
+// the exceeded threshold.
+func ResourceStalled(workflowID, actionID, detail string) Event {
+	ev := resourceEvent(EventResourceStalled, workflowID, actionID, "")
+	ev.Detail = detail
+	return ev
+}
@@ -33,6 +33,9 @@ func (ctx *ExecutionContext) File(pattern string, selector ...string) (interface
 		return ctx.handleAgentData(pattern, selector)
 	}
 
+	// Materialize lazily-extracted data files before reading.
+	ctx.ensureLazyFile(pattern)
+
 	// Build absolute path
 	absPattern := filepath.Join(ctx.FSRoot, pattern)
 
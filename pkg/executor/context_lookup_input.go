@@ -43,7 +43,7 @@ func (ctx *ExecutionContext) getWithAutoDetection(name string) (interface{}, err
 	}
 
 	// 2. Memory storage
-	if val, exists := ctx.Memory.Get(name); exists {
+	if val, exists := ctx.Memory.Get(ctx.tenantScopedKey(name)); exists {
 		return val, nil
 	}
 
@@ -54,7 +54,7 @@ func (ctx *ExecutionContext) getWithAutoDetection(name string) (interface{}, err
 
 	// 4. Resource outputs (actionID)
 	if val, ok := ctx.Outputs[name]; ok {
-		return val, nil
+		return resolveOutputValue(val), nil
 	}
 
 	// 4.5. Input processor results — accessible as get("inputTranscript") / get("inputMedia")
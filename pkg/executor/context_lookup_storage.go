@@ -87,7 +87,7 @@ func (ctx *ExecutionContext) uploadedFileField(name string, field func(*FileUplo
 // getMemory retrieves a value from Memory storage.
 func (ctx *ExecutionContext) getMemory(name string) (interface{}, error) {
 	kdeps_debug.Log("enter: getMemory")
-	if val, exists := ctx.Memory.Get(name); exists {
+	if val, exists := ctx.Memory.Get(ctx.tenantScopedKey(name)); exists {
 		return val, nil
 	}
 	return nil, fmt.Errorf("memory key '%s' not found", name)
@@ -115,7 +115,7 @@ func (ctx *ExecutionContext) GetAllSession() (map[string]interface{}, error) {
 func (ctx *ExecutionContext) getOutput(name string) (interface{}, error) {
 	kdeps_debug.Log("enter: getOutput")
 	if val, ok := ctx.Outputs[name]; ok {
-		return val, nil
+		return resolveOutputValue(val), nil
 	}
 	return nil, fmt.Errorf("output '%s' not found", name)
 }
@@ -32,25 +32,26 @@ func (ctx *ExecutionContext) Output(resourceID string) (interface{}, error) {
 	defer ctx.mu.RUnlock()
 
 	if val, ok := ctx.Outputs[resourceID]; ok {
-		return val, nil
+		return resolveOutputValue(val), nil
 	}
 
 	return nil, fmt.Errorf("output for resource '%s' not found", resourceID)
 }
 
-// SetOutput stores a resource output.
+// SetOutput stores a resource output. Payloads over the spill threshold are
+// written to a sandbox file and kept as a lazy reference instead.
 func (ctx *ExecutionContext) SetOutput(actionID string, output interface{}) {
 	kdeps_debug.Log("enter: SetOutput")
 	ctx.mu.Lock()
 	defer ctx.mu.Unlock()
-	ctx.Outputs[actionID] = output
+	ctx.Outputs[actionID] = ctx.maybeSpillOutput(output)
 }
 
-// GetOutput retrieves a resource output.
+// GetOutput retrieves a resource output, loading spilled payloads lazily.
 func (ctx *ExecutionContext) GetOutput(actionID string) (interface{}, bool) {
 	kdeps_debug.Log("enter: GetOutput")
 	ctx.mu.RLock()
 	defer ctx.mu.RUnlock()
 	output, ok := ctx.Outputs[actionID]
-	return output, ok
+	return resolveOutputValue(output), ok
 }
@@ -50,7 +50,7 @@ func (ctx *ExecutionContext) resourceOutput(actionID string) (interface{}, error
 	if !ok {
 		return nil, fmt.Errorf("output for resource '%s' not found", actionID)
 	}
-	return output, nil
+	return resolveOutputValue(output), nil
 }
 
 func extractLLMResponseFromMap(outputMap map[string]interface{}) interface{} {
@@ -28,10 +28,11 @@ import (
 // GetLLMResponse retrieves LLM response text from resource output.
 func (ctx *ExecutionContext) GetLLMResponse(actionID string) (interface{}, error) {
 	kdeps_debug.Log("enter: GetLLMResponse")
-	output, ok := ctx.Outputs[actionID]
+	rawOutput, ok := ctx.Outputs[actionID]
 	if !ok {
 		return nil, fmt.Errorf("output for resource '%s' not found", actionID)
 	}
+	output := resolveOutputValue(rawOutput)
 
 	// LLM output is typically a string (response text)
 	if responseStr, okStr := output.(string); okStr {
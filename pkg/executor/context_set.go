@@ -45,7 +45,7 @@ func (ctx *ExecutionContext) Set(key string, value interface{}, storageType ...s
 
 	switch storage {
 	case storageTypeMemory:
-		return ctx.Memory.Set(key, value)
+		return ctx.Memory.Set(ctx.tenantScopedKey(key), value)
 
 	case storageTypeSession:
 		return ctx.Session.Set(key, value)
@@ -47,6 +47,10 @@ type ExecutionContext struct {
 	// Resource outputs (actionID -> output).
 	Outputs map[string]interface{}
 
+	// retainedOutputBytes tracks in-memory textual output size for the
+	// per-run spill guardrail (see context_output_spill.go).
+	retainedOutputBytes int64
+
 	// Items iteration context.
 	Items map[string]interface{}
 
@@ -62,6 +66,9 @@ type ExecutionContext struct {
 	// LLM metadata (model and backend used in this execution).
 	LLMMetadata *LLMMetadata
 
+	// RunCost accumulates declared resource costs (actionID -> cost).
+	RunCost map[string]float64
+
 	// InputMediaFile is the path to the captured or transcribed media file produced
 	// by the input processor (audio/video/telephony sources with output: media).
 	// Resources can read this path via the inputMedia expression function.
@@ -117,6 +124,7 @@ type ExecutionContext struct {
 type LLMMetadata struct {
 	Model   string
 	Backend string
+	Variant string // experiment variant label, empty when no experiment ran
 }
 
 // BotSendFunc delivers a reply text to the originating bot platform.
@@ -137,6 +145,15 @@ type RequestContext struct {
 	IP        string       // Client IP address
 	ID        string       // Request ID
 	SessionID string       // Session ID from cookie (if available)
+	Tenant    string       // Tenant name resolved from the API key (empty = untenanted)
+
+	// TargetActionID overrides the workflow's targetActionId for this
+	// request (per-route entrypoints).
+	TargetActionID string
+
+	// Params are path parameters captured by the matched route pattern
+	// (e.g. /users/{id}), exposed to expressions as request.params.
+	Params map[string]string
 
 	// BotSend is set by the bot dispatcher/stateless runner so that the
 	// botReply resource executor can deliver the reply without knowing
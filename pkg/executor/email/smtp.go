@@ -27,6 +27,7 @@ import (
 	kdeps_debug "github.com/kdeps/kdeps/v2/pkg/debug"
 	"github.com/kdeps/kdeps/v2/pkg/domain"
 	"github.com/kdeps/kdeps/v2/pkg/executor"
+	"github.com/kdeps/kdeps/v2/pkg/infra/health"
 )
 
 func resolveNamedConnection[T any](
@@ -62,7 +63,7 @@ func (e *Executor) resolveSMTPConfig(
 	cfg *domain.EmailConfig,
 ) (kdepsconfig.SMTPConnectionConfig, error) {
 	kdeps_debug.Log("enter: resolveSMTPConfig")
-	return resolveNamedConnection(
+	conn, err := resolveNamedConnection(
 		ctx,
 		cfg.SMTPConnection,
 		errors.New(
@@ -73,6 +74,18 @@ func (e *Executor) resolveSMTPConfig(
 		"email executor: smtpConnection %q not found in ~/.kdeps/config.yaml smtp_connections",
 		smtpConnectionsFrom(ctx),
 	)
+	if err != nil {
+		return conn, err
+	}
+	// Fail over to the configured secondary when the health monitor has
+	// observed this connection unhealthy.
+	picked := health.Default().PickConnection("smtp", cfg.SMTPConnection, conn.Fallback)
+	if picked != cfg.SMTPConnection {
+		if fallbackConn, ok := smtpConnectionsFrom(ctx)[picked]; ok {
+			return fallbackConn, nil
+		}
+	}
+	return conn, nil
 }
 
 // sendRequest holds evaluated SMTP send parameters.
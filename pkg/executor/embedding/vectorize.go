@@ -54,20 +54,56 @@ const (
 )
 
 // vectorizeInputs embeds cfg.Inputs using the configured model/backend and
-// returns a JSON object: {"model": "...", "vectors": [[...],...]}.
+// returns a JSON object: {"model": "...", "vectors": [[...],...]}. Vectors
+// for unchanged inputs come from the content-hash cache; only misses hit the
+// embedding API.
 func vectorizeInputs(ctx context.Context, cfg *domain.EmbeddingConfig) (map[string]interface{}, error) {
 	if len(cfg.Inputs) == 0 {
 		return nil, errors.New("embedding vectorize: no inputs provided")
 	}
 
-	embedder, err := buildEmbedderFunc(ctx, cfg)
-	if err != nil {
-		return nil, err
+	cache, cacheErr := openVectorCache(cfg)
+	if cacheErr != nil {
+		return nil, cacheErr
 	}
+	defer cache.Close()
 
-	vectors, err := embedder.EmbedDocuments(ctx, cfg.Inputs)
-	if err != nil {
-		return nil, fmt.Errorf("embedding vectorize: %w", err)
+	vectors := make([][]float32, len(cfg.Inputs))
+	missing := make([]int, 0, len(cfg.Inputs))
+	hits := 0
+	for i, input := range cfg.Inputs {
+		if !cacheBypassed(cfg) {
+			if cached := cache.get(input, cfg.Model); cached != nil {
+				vectors[i] = cached
+				hits++
+				continue
+			}
+		}
+		missing = append(missing, i)
+	}
+
+	if len(missing) > 0 {
+		embedder, err := buildEmbedderFunc(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+		inputs := make([]string, len(missing))
+		for j, i := range missing {
+			inputs[j] = cfg.Inputs[i]
+		}
+		computed, err := embedder.EmbedDocuments(ctx, inputs)
+		if err != nil {
+			return nil, fmt.Errorf("embedding vectorize: %w", err)
+		}
+		if len(computed) != len(missing) {
+			return nil, fmt.Errorf(
+				"embedding vectorize: got %d vectors for %d inputs", len(computed), len(missing),
+			)
+		}
+		for j, i := range missing {
+			vectors[i] = computed[j]
+			cache.put(cfg.Inputs[i], cfg.Model, computed[j])
+		}
 	}
 
 	b, merr := json.Marshal(vectors)
@@ -76,9 +112,11 @@ func vectorizeInputs(ctx context.Context, cfg *domain.EmbeddingConfig) (map[stri
 	}
 
 	return map[string]interface{}{
-		"model":   cfg.Model,
-		"count":   len(vectors),
-		"vectors": string(b),
+		"model":       cfg.Model,
+		"count":       len(vectors),
+		"vectors":     string(b),
+		"cacheHits":   hits,
+		"cacheMisses": len(missing),
 	}, nil
 }
 
@@ -89,14 +127,37 @@ func embedQuery(ctx context.Context, cfg *domain.EmbeddingConfig) (map[string]in
 		return nil, errors.New("embedding embed_query: text is required")
 	}
 
-	embedder, err := buildEmbedderFunc(ctx, cfg)
-	if err != nil {
-		return nil, err
+	cache, cacheErr := openVectorCache(cfg)
+	if cacheErr != nil {
+		return nil, cacheErr
 	}
+	defer cache.Close()
 
-	vector, err := embedder.EmbedQuery(ctx, cfg.Text)
-	if err != nil {
-		return nil, fmt.Errorf("embedding embed_query: %w", err)
+	var vector []float32
+	cacheHit := false
+	if !cacheBypassed(cfg) {
+		if cached := cache.get(cfg.Text, cfg.Model); cached != nil {
+			vector = cached
+			cacheHit = true
+		}
+	}
+
+	if !cacheHit {
+		var err error
+		if window := batchWindow(cfg); window > 0 {
+			vector, err = embedViaBatcher(cfg, window)
+		} else {
+			var embedder lcemb.Embedder
+			embedder, err = buildEmbedderFunc(ctx, cfg)
+			if err != nil {
+				return nil, err
+			}
+			vector, err = embedder.EmbedQuery(ctx, cfg.Text)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("embedding embed_query: %w", err)
+		}
+		cache.put(cfg.Text, cfg.Model, vector)
 	}
 
 	b, merr := json.Marshal(vector)
@@ -105,8 +166,9 @@ func embedQuery(ctx context.Context, cfg *domain.EmbeddingConfig) (map[string]in
 	}
 
 	return map[string]interface{}{
-		"model":  cfg.Model,
-		"vector": string(b),
+		"model":    cfg.Model,
+		"vector":   string(b),
+		"cacheHit": cacheHit,
 	}, nil
 }
 
@@ -23,10 +23,12 @@ package executor
 
 import (
 	"fmt"
+	"time"
 
 	kdeps_debug "github.com/kdeps/kdeps/v2/pkg/debug"
 
 	"github.com/kdeps/kdeps/v2/pkg/domain"
+	"github.com/kdeps/kdeps/v2/pkg/events"
 )
 
 // Execute executes a workflow.
@@ -58,18 +60,31 @@ func (e *Engine) Execute(workflow *domain.Workflow, req interface{}) (interface{
 		return nil, initErr
 	}
 
-	resources, targetActionID, err := e.prepareWorkflowExecution(workflow)
+	requestTarget := ""
+	if reqCtx != nil {
+		requestTarget = reqCtx.TargetActionID
+	}
+	resources, targetActionID, err := e.prepareWorkflowExecution(workflow, requestTarget)
 	if err != nil {
 		return nil, err
 	}
 
+	started := time.Now()
 	for _, resource := range resources {
 		if runErr := e.runWorkflowResource(workflow, resource, ctx, reqCtx); runErr != nil {
 			return nil, runErr
 		}
 	}
 
-	return e.finalizeWorkflowOutput(workflow, ctx, targetActionID)
+	result, err := e.finalizeWorkflowOutput(workflow, ctx, targetActionID)
+	if err == nil {
+		if reqCtx != nil && len(ctx.RunCost) > 0 {
+			events.RecordRouteCost(reqCtx.Method+" "+reqCtx.Path, ctx.RunCostTotal())
+			events.RecordTenantCost(reqCtx.Tenant, ctx.RunCostTotal())
+		}
+		e.maybeRecordRun(workflow, reqCtx, ctx, result, started)
+	}
+	return result, err
 }
 
 // ExecuteWithLoop executes a resource body repeatedly while the loop's While condition is true.
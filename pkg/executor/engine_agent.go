@@ -53,6 +53,18 @@ func (e *Engine) executeInlineAgent(
 		return nil, errors.New("agent call configuration is nil")
 	}
 
+	// Agents outside the local agency resolve through the supervisor's
+	// alias registry (kdeps up) and are called over HTTP.
+	if _, local := ctx.AgentPaths[cfg.Name]; !local {
+		if baseURL, ok := ResolveAgentAlias(cfg.Name); ok {
+			params, paramsErr := evaluateAgentParams(e, cfg, ctx)
+			if paramsErr != nil {
+				return nil, paramsErr
+			}
+			return invokeAliasedAgent(cfg.Name, baseURL, params)
+		}
+	}
+
 	agentPath, err := resolveAgentPath(cfg, ctx)
 	if err != nil {
 		return nil, err
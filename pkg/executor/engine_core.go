@@ -47,6 +47,12 @@ type Engine struct {
 	debugMode           bool
 	emitter             events.Emitter
 	componentSetupCache sync.Map // keyed by component name, value struct{}{}
+	// timingHints maps actionID -> historical average seconds, used to order
+	// independent resources shortest-first during graph sorting.
+	timingHints map[string]float64
+	// replay, when set, substitutes recorded outputs for execution
+	// (kdeps replay).
+	replay *replayState
 }
 
 type inputValidator interface {
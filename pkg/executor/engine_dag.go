@@ -27,6 +27,7 @@ import (
 func (e *Engine) BuildGraph(workflow *domain.Workflow) error {
 	kdeps_debug.Log("enter: BuildGraph")
 	e.graph = NewGraph()
+	e.graph.SetTimingHints(e.timingHints)
 
 	// Add all resources to graph.
 	for _, resource := range workflow.Resources {
@@ -51,6 +51,9 @@ func (e *Engine) ExecuteResource(
 	hasPrimaryType := hasPrimaryResourceType(resource)
 	var primaryResult interface{}
 	if hasPrimaryType {
+		if residencyErr := e.enforceResidency(resource, ctx); residencyErr != nil {
+			return nil, residencyErr
+		}
 		var execErr error
 		primaryResult, execErr = e.dispatchPrimaryResource(resource, ctx)
 		if execErr != nil {
@@ -157,6 +157,27 @@ func inlineResourceDispatch() []inlineDispatchEntry {
 		ExecutorTranscribe: func(e *Engine, inline *domain.InlineResource, _ int, ctx *ExecutionContext) (interface{}, error) {
 			return e.executeInlineTranscribe(inline.Transcribe, ctx)
 		},
+		ExecutorDocs: func(e *Engine, inline *domain.InlineResource, _ int, ctx *ExecutionContext) (interface{}, error) {
+			return e.executeInlineDocs(inline.Docs, ctx)
+		},
+		ExecutorPayments: func(e *Engine, inline *domain.InlineResource, _ int, ctx *ExecutionContext) (interface{}, error) {
+			return e.executeInlinePayments(inline.Payments, ctx)
+		},
+		ExecutorSMS: func(e *Engine, inline *domain.InlineResource, _ int, ctx *ExecutionContext) (interface{}, error) {
+			return e.executeInlineSMS(inline.SMS, ctx)
+		},
+		ExecutorPush: func(e *Engine, inline *domain.InlineResource, _ int, ctx *ExecutionContext) (interface{}, error) {
+			return e.executeInlinePush(inline.Push, ctx)
+		},
+		ExecutorRerank: func(e *Engine, inline *domain.InlineResource, _ int, ctx *ExecutionContext) (interface{}, error) {
+			return e.executeInlineRerank(inline.Rerank, ctx)
+		},
+		ExecutorOrchestrate: func(e *Engine, inline *domain.InlineResource, _ int, ctx *ExecutionContext) (interface{}, error) {
+			return e.executeInlineOrchestrate(inline.Orchestrate, ctx)
+		},
+		ExecutorPlugin: func(e *Engine, inline *domain.InlineResource, _ int, ctx *ExecutionContext) (interface{}, error) {
+			return e.executeInlinePlugin(inline.Plugin, ctx)
+		},
 		ExecutorBotReply: func(e *Engine, inline *domain.InlineResource, index int, ctx *ExecutionContext) (interface{}, error) {
 			return e.executeBotReply(inlineSyntheticResource(inline, index), ctx)
 		},
@@ -24,6 +24,7 @@ import (
 
 	kdeps_debug "github.com/kdeps/kdeps/v2/pkg/debug"
 	"github.com/kdeps/kdeps/v2/pkg/domain"
+	"github.com/kdeps/kdeps/v2/pkg/events"
 )
 
 // handleOnErrorAction applies the configured onError action after retries are exhausted.
@@ -63,6 +64,9 @@ func (e *Engine) handleOnErrorContinue(
 		}
 		e.logger.Info("Using fallback value",
 			"actionID", resource.ActionID)
+		e.emitter.Emit(events.ResourceFallback(
+			workflowIDFromContext(ctx), resource.ActionID, lastErr,
+		))
 		return fallbackOutput, nil
 	}
 
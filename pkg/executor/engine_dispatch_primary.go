@@ -55,6 +55,13 @@ func primaryResourceDispatch() []primaryDispatchEntry {
 		ExecutorLoader:      (*Engine).executeLoader,
 		ExecutorVectorStore: (*Engine).executeVectorStore,
 		ExecutorTranscribe:  (*Engine).executeTranscribe,
+		ExecutorDocs:        (*Engine).executeDocs,
+		ExecutorPayments:    (*Engine).executePayments,
+		ExecutorSMS:         (*Engine).executeSMS,
+		ExecutorPush:        (*Engine).executePush,
+		ExecutorRerank:      (*Engine).executeRerank,
+		ExecutorOrchestrate: (*Engine).executeOrchestrate,
+		ExecutorPlugin:      (*Engine).executePlugin,
 		ExecutorAPIResponse: (*Engine).executeAPIResponse,
 	}
 
@@ -24,8 +24,17 @@ import (
 
 	kdeps_debug "github.com/kdeps/kdeps/v2/pkg/debug"
 	"github.com/kdeps/kdeps/v2/pkg/domain"
+	"github.com/kdeps/kdeps/v2/pkg/events"
 )
 
+// workflowIDFromContext returns the workflow name for event attribution.
+func workflowIDFromContext(ctx *ExecutionContext) string {
+	if ctx == nil || ctx.Workflow == nil {
+		return ""
+	}
+	return ctx.Workflow.Metadata.Name
+}
+
 // executeResourceWithErrorHandling wraps ExecuteResource with onError handling.
 func (e *Engine) executeResourceWithErrorHandling(
 	resource *domain.Resource,
@@ -109,6 +118,9 @@ func (e *Engine) runResourceWithRetries(
 		if attempt >= maxRetries || onError.Action != onErrorActionRetry {
 			break
 		}
+		e.emitter.Emit(events.ResourceRetrying(
+			workflowIDFromContext(ctx), resource.ActionID, attempt+1, maxRetries,
+		))
 		if retryDelay > 0 {
 			time.Sleep(retryDelay)
 		}
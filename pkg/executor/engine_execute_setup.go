@@ -62,6 +62,7 @@ func (e *Engine) setupExecutionContext(
 	reqCtx *RequestContext,
 ) {
 	ctx.Request = reqCtx
+	PrefetchLazyData(ctx.FSRoot, workflow.Settings.AgentSettings.DataPrefetch)
 	if reqCtx != nil && reqCtx.BotSend != nil {
 		ctx.BotSend = reqCtx.BotSend
 	}
@@ -113,6 +114,7 @@ func (e *Engine) initWorkflowEvaluator(ctx *ExecutionContext) error {
 // prepareWorkflowExecution builds the graph, emits workflow.started, and resolves execution order.
 func (e *Engine) prepareWorkflowExecution(
 	workflow *domain.Workflow,
+	requestTarget string,
 ) ([]*domain.Resource, string, error) {
 	if buildErr := e.BuildGraph(workflow); buildErr != nil {
 		return nil, "", domain.NewError(
@@ -122,8 +124,13 @@ func (e *Engine) prepareWorkflowExecution(
 		)
 	}
 
-	e.emitter.Emit(events.WorkflowStarted(workflow.Metadata.Name))
+	SetSubprocessEnvPolicy(workflow.Settings.Env)
+	e.emitter.Emit(events.WorkflowStartedWithTotal(workflow.Metadata.Name, len(workflow.Resources)))
 	targetActionID := workflow.Metadata.TargetActionID
+	if requestTarget != "" {
+		// Per-route entrypoint: the matched route pins its own response node.
+		targetActionID = requestTarget
+	}
 
 	e.logger.Info("Building execution graph",
 		"total_resources", len(workflow.Resources),
@@ -158,3 +158,68 @@ func (e *Engine) executeInlineTranscribe(
 		"executeInlineTranscribe", e.registry.GetTranscribeExecutor, "transcribe", ctx, config,
 	)
 }
+
+// executeDocs executes a docs resource.
+func (e *Engine) executeDocs(resource *domain.Resource, ctx *ExecutionContext) (interface{}, error) {
+	return e.executeRegisteredResource(
+		resource, "docs", resource.Docs,
+		e.registry.GetDocsExecutor, "docs", "executeDocs", ctx,
+	)
+}
+
+// executeInlineDocs executes an inline docs resource.
+func (e *Engine) executeInlineDocs(config *domain.DocsConfig, ctx *ExecutionContext) (interface{}, error) {
+	return e.executeRegistered("executeInlineDocs", e.registry.GetDocsExecutor, "docs", ctx, config)
+}
+
+// executePayments executes a payments resource.
+func (e *Engine) executePayments(resource *domain.Resource, ctx *ExecutionContext) (interface{}, error) {
+	return e.executeRegisteredResource(
+		resource, "payments", resource.Payments,
+		e.registry.GetPaymentsExecutor, "payments", "executePayments", ctx,
+	)
+}
+
+// executeInlinePayments executes an inline payments resource.
+func (e *Engine) executeInlinePayments(config *domain.PaymentsConfig, ctx *ExecutionContext) (interface{}, error) {
+	return e.executeRegistered("executeInlinePayments", e.registry.GetPaymentsExecutor, "payments", ctx, config)
+}
+
+// executeSMS executes a sms resource.
+func (e *Engine) executeSMS(resource *domain.Resource, ctx *ExecutionContext) (interface{}, error) {
+	return e.executeRegisteredResource(
+		resource, "sms", resource.SMS,
+		e.registry.GetSMSExecutor, "sms", "executeSMS", ctx,
+	)
+}
+
+// executeInlineSMS executes an inline sms resource.
+func (e *Engine) executeInlineSMS(config *domain.SMSConfig, ctx *ExecutionContext) (interface{}, error) {
+	return e.executeRegistered("executeInlineSMS", e.registry.GetSMSExecutor, "sms", ctx, config)
+}
+
+// executePush executes a push resource.
+func (e *Engine) executePush(resource *domain.Resource, ctx *ExecutionContext) (interface{}, error) {
+	return e.executeRegisteredResource(
+		resource, "push", resource.Push,
+		e.registry.GetPushExecutor, "push", "executePush", ctx,
+	)
+}
+
+// executeInlinePush executes an inline push resource.
+func (e *Engine) executeInlinePush(config *domain.PushConfig, ctx *ExecutionContext) (interface{}, error) {
+	return e.executeRegistered("executeInlinePush", e.registry.GetPushExecutor, "push", ctx, config)
+}
+
+// executeRerank executes a rerank resource.
+func (e *Engine) executeRerank(resource *domain.Resource, ctx *ExecutionContext) (interface{}, error) {
+	return e.executeRegisteredResource(
+		resource, "rerank", resource.Rerank,
+		e.registry.GetRerankExecutor, "rerank", "executeRerank", ctx,
+	)
+}
+
+// executeInlineRerank executes an inline rerank resource.
+func (e *Engine) executeInlineRerank(config *domain.RerankConfig, ctx *ExecutionContext) (interface{}, error) {
+	return e.executeRegistered("executeInlineRerank", e.registry.GetRerankExecutor, "rerank", ctx, config)
+}
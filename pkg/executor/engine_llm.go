@@ -38,22 +38,31 @@ func (e *Engine) executeLLM(resource *domain.Resource, ctx *ExecutionContext) (i
 		return nil, errors.New("LLM executor not available")
 	}
 
-	timeoutDuration, timeoutDurationStr := e.resolveLLMTimeout(resource.Chat)
-	backendName := e.resolveLLMBackend(resource.Chat)
-	modelStr := e.evaluateLLMModel(resource.Chat.Model, ctx)
+	chat, variantName := e.applyChatExperiment(resource.Chat, ctx)
+
+	timeoutDuration, timeoutDurationStr := e.resolveLLMTimeout(chat)
+	backendName := e.resolveLLMBackend(chat)
+	modelStr := e.evaluateLLMModel(chat.Model, ctx)
+	chat, modelStr = e.applyStickyModel(ctx, chat, modelStr)
 
 	e.logger.Info("LLM resource configuration",
 		"actionID", resource.ActionID,
 		"model", modelStr,
 		"timeout", timeoutDurationStr,
-		"jsonResponse", resource.Chat.JSONResponse,
-		"backend", backendName)
+		"jsonResponse", chat.JSONResponse,
+		"backend", backendName,
+		"variant", variantName)
+
+	if degraded, handled, degradeErr := e.applyLLMDegradation(chat, backendName, ctx); handled {
+		return degraded, degradeErr
+	}
 
 	e.updateLLMMetadata(ctx, modelStr, backendName)
+	e.setLLMVariant(ctx, variantName)
 	e.configureLLMExecutor(llmExecutor, ctx)
 
 	done := e.startLLMTimeoutCountdown(resource.ActionID, timeoutDuration)
-	result, execErr := llmExecutor.Execute(ctx, resource.Chat)
+	result, execErr := llmExecutor.Execute(ctx, chat)
 	if done != nil {
 		close(done)
 	}
@@ -71,5 +80,7 @@ func (e *Engine) executeInlineLLM(
 		return nil, errors.New("LLM executor not available")
 	}
 
-	return executor.Execute(ctx, config)
+	chat, variantName := e.applyChatExperiment(config, ctx)
+	e.setLLMVariant(ctx, variantName)
+	return executor.Execute(ctx, chat)
 }
@@ -97,3 +97,17 @@ func (e *Engine) updateLLMMetadata(ctx *ExecutionContext, model string, backendN
 	ctx.LLMMetadata.Model = evaluatedModel
 	ctx.LLMMetadata.Backend = backendName
 }
+
+// setLLMVariant records the experiment variant label in the LLM metadata.
+// A no-op when no experiment ran.
+func (e *Engine) setLLMVariant(ctx *ExecutionContext, variant string) {
+	if variant == "" {
+		return
+	}
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+	if ctx.LLMMetadata == nil {
+		ctx.LLMMetadata = &LLMMetadata{}
+	}
+	ctx.LLMMetadata.Variant = variant
+}
@@ -36,6 +36,33 @@ func (e *Engine) SetEmitter(em events.Emitter) {
 	e.emitter = em
 }
 
+// Emitter returns the engine's current event emitter.
+func (e *Engine) Emitter() events.Emitter {
+	return e.emitter
+}
+
+// Subscribe attaches fn to the engine's lifecycle event stream (run and
+// resource start/finish, retries, fallbacks) and returns a cancel
+// function. The first subscription wraps the current emitter in an
+// events.Bus; existing consumers keep receiving everything. Call before
+// Execute; subscribers run synchronously and must not block.
+func (e *Engine) Subscribe(fn func(events.Event)) func() {
+	kdeps_debug.Log("enter: Subscribe")
+	bus, ok := e.emitter.(*events.Bus)
+	if !ok {
+		bus = events.NewBus(e.emitter)
+		e.emitter = bus
+	}
+	return bus.Subscribe(fn)
+}
+
+// SetTimingHints installs historical per-resource durations (seconds by
+// actionID) used to schedule independent resources shortest-first.
+func (e *Engine) SetTimingHints(hints map[string]float64) {
+	kdeps_debug.Log("enter: SetTimingHints")
+	e.timingHints = hints
+}
+
 // SetRegistry sets the executor registry.
 func (e *Engine) SetRegistry(registry *Registry) {
 	kdeps_debug.Log("enter: SetRegistry")
@@ -67,6 +67,10 @@ func (e *Engine) runWorkflowResource(
 		return nil
 	}
 
+	if capErr := checkModelCapabilities(resource, ctx); capErr != nil {
+		return capErr
+	}
+
 	if preflightErr := e.RunPreflightCheck(resource, ctx); preflightErr != nil {
 		return fmt.Errorf(
 			"preflight check failed for %s: %w",
@@ -81,7 +85,38 @@ func (e *Engine) runWorkflowResource(
 		return validateErr
 	}
 
+	if recorded, ok := e.replayOutput(resource.ActionID); ok {
+		e.logger.Info("Replaying recorded output", "actionID", resource.ActionID)
+		ctx.SetOutput(resource.ActionID, recorded)
+		e.emitter.Emit(events.ResourceCompleted(
+			workflow.Metadata.Name, resource.ActionID, resourceTypeName(resource),
+		))
+		return nil
+	}
+
+	onceKey, onceErr := e.resolveOnceKey(resource, ctx)
+	if onceErr != nil {
+		return onceErr
+	}
+	if stored, hit := e.lookupOnceResult(ctx, onceKey); hit {
+		e.logger.Info("Returning stored output (once guard)",
+			"actionID", resource.ActionID)
+		ctx.SetOutput(resource.ActionID, stored)
+		e.emitter.Emit(events.ResourceCompleted(
+			workflow.Metadata.Name, resource.ActionID, resourceTypeName(resource),
+		))
+		return nil
+	}
+
+	releaseLock, lockErr := e.acquireResourceLock(resource, ctx)
+	if lockErr != nil {
+		return fmt.Errorf("resource execution failed for %s: %w", resource.ActionID, lockErr)
+	}
+
+	stopWatchdog := e.startResourceWatchdog(workflow.Metadata.Name, resource.ActionID)
 	output, execErr := e.executeResourceWithErrorHandling(resource, ctx)
+	stopWatchdog()
+	releaseLock()
 	if execErr != nil {
 		e.emitter.Emit(events.ResourceFailed(
 			workflow.Metadata.Name,
@@ -97,7 +132,10 @@ func (e *Engine) runWorkflowResource(
 		)
 	}
 
+	recordResourceOutputSize(resource.ActionID, output)
+	e.recordResourceCost(resource, ctx, output)
 	ctx.SetOutput(resource.ActionID, output)
+	e.storeOnceResult(ctx, onceKey, output)
 	e.logger.Info("Resource completed",
 		"actionID", resource.ActionID,
 		"output", output)
@@ -45,6 +45,14 @@ func (e *Engine) executeAPIResponse(
 	if err != nil {
 		return nil, fmt.Errorf("failed to evaluate API response: %w", err)
 	}
+	if len(apiResponseConfig.Transform) > 0 {
+		evaluatedResponse, err = applyResponseTransforms(
+			evaluatedResponse, apiResponseConfig.Transform,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	successBool, err := e.resolveAPIResponseSuccess(apiResponseConfig, env)
 	if err != nil {
@@ -60,6 +68,7 @@ func (e *Engine) executeAPIResponse(
 		apiResponse["_meta"] = metaMap
 	}
 	e.applyLLMMetadataToResponse(apiResponse, ctx)
+	e.applyCostMetadataToResponse(apiResponse, ctx)
 
 	return apiResponse, nil
 }
@@ -116,7 +116,8 @@ func (e *Engine) applyLLMMetadataToResponse(
 	if ctx == nil || ctx.LLMMetadata == nil {
 		return
 	}
-	if ctx.LLMMetadata.Model == "" && ctx.LLMMetadata.Backend == "" {
+	if ctx.LLMMetadata.Model == "" && ctx.LLMMetadata.Backend == "" &&
+		ctx.LLMMetadata.Variant == "" {
 		return
 	}
 
@@ -130,6 +131,9 @@ func (e *Engine) applyLLMMetadataToResponse(
 	if ctx.LLMMetadata.Backend != "" && metaMap["backend"] == nil {
 		metaMap["backend"] = ctx.LLMMetadata.Backend
 	}
+	if ctx.LLMMetadata.Variant != "" && metaMap["variant"] == nil {
+		metaMap["variant"] = ctx.LLMMetadata.Variant
+	}
 	// The guards above ensure at least one key was set on a fresh map.
 	if !exists {
 		apiResponse["_meta"] = metaMap
@@ -18,7 +18,13 @@
 
 package executor
 
-import kdeps_debug "github.com/kdeps/kdeps/v2/pkg/debug"
+import (
+	"fmt"
+
+	kdeps_debug "github.com/kdeps/kdeps/v2/pkg/debug"
+	"github.com/kdeps/kdeps/v2/pkg/i18n"
+	"github.com/kdeps/kdeps/v2/pkg/security/secrets"
+)
 
 // EvalEnvProfile selects which layers compose an expression evaluation environment.
 type EvalEnvProfile int
@@ -60,6 +66,13 @@ func buildSubExecutorEvalEnv(ctx *ExecutionContext, profile EvalEnvProfile) map[
 	env := make(map[string]interface{})
 	env["outputs"] = ctx.Outputs
 	addBasicRequestEnv(env, ctx)
+	addSecretEnv(env, ctx)
+	addI18nEnv(env, ctx)
+	addLockEnv(env, ctx)
+	addConstEnv(env, ctx)
+	addSessionLifecycleEnv(env, ctx)
+	addFormatEnv(env)
+	addModelEnv(env, ctx)
 	if profile == EvalEnvRequest {
 		addRequestBodyInputEnv(env, ctx)
 		addRawItemEnv(env, ctx)
@@ -67,9 +80,89 @@ func buildSubExecutorEvalEnv(ctx *ExecutionContext, profile EvalEnvProfile) map[
 	return env
 }
 
+// addI18nEnv exposes t('key') / t('key', vars): translations resolve from
+// the agent's i18n/ catalogs using the request's explicit locale query
+// param, then Accept-Language, then "en".
+func addI18nEnv(env map[string]interface{}, ctx *ExecutionContext) {
+	env["t"] = func(args ...interface{}) interface{} {
+		if len(args) == 0 {
+			return ""
+		}
+		key := fmt.Sprintf("%v", args[0])
+		var vars map[string]interface{}
+		if len(args) > 1 {
+			vars, _ = args[1].(map[string]interface{})
+		}
+
+		locale := i18n.DefaultLocale
+		dir := ""
+		if ctx != nil {
+			dir = ctx.FSRoot
+			if ctx.Request != nil {
+				locale = i18n.ResolveLocale(
+					ctx.Request.Query["locale"],
+					ctx.Request.Headers["Accept-Language"],
+				)
+			}
+		}
+		return i18n.Translate(dir, locale, key, vars)
+	}
+}
+
+// addFormatEnv exposes locale-aware formatting helpers:
+// format.currency(value, code, locale), format.number(value, locale), and
+// format.date(value, locale, style).
+func addFormatEnv(env map[string]interface{}) {
+	env["format"] = map[string]interface{}{
+		"currency": func(value float64, code, locale string) string {
+			return i18n.FormatCurrency(value, code, locale)
+		},
+		"number": func(value float64, locale string) string {
+			return i18n.FormatNumber(value, locale)
+		},
+		"date": func(args ...interface{}) interface{} {
+			if len(args) == 0 {
+				return ""
+			}
+			locale, style := "en", "short"
+			if len(args) > 1 {
+				locale = fmt.Sprintf("%v", args[1])
+			}
+			if len(args) > 2 {
+				style = fmt.Sprintf("%v", args[2])
+			}
+			return i18n.FormatDate(args[0], locale, style)
+		},
+	}
+}
+
+// addSecretEnv exposes secret('name'): resolved at render time from the
+// secrets providers, registered for log masking, and never stored by the
+// engine. Unresolvable secrets evaluate to nil.
+func addSecretEnv(env map[string]interface{}, ctx *ExecutionContext) {
+	env["secret"] = func(name string) interface{} {
+		var configSecrets map[string]string
+		if ctx != nil && ctx.Config != nil {
+			configSecrets = ctx.Config.Secrets
+		}
+		value, err := secrets.Resolve(configSecrets, name)
+		if err != nil {
+			return nil
+		}
+		return value
+	}
+}
+
 func buildResourceEvalEnv(ctx *ExecutionContext) map[string]interface{} {
 	env := make(map[string]interface{})
 	addCoreResourceAccessors(env, ctx)
+	addSecretEnv(env, ctx)
+	addI18nEnv(env, ctx)
+	addLockEnv(env, ctx)
+	addConstEnv(env, ctx)
+	addSessionLifecycleEnv(env, ctx)
+	addFormatEnv(env)
+	addModelEnv(env, ctx)
 	env["item"] = buildItemAccessorEnv(ctx, true)
 	return env
 }
@@ -87,6 +180,13 @@ func buildLLMEvalEnv(ctx *ExecutionContext) map[string]interface{} {
 func buildEngineEvalEnv(ctx *ExecutionContext) map[string]interface{} {
 	env := make(map[string]interface{})
 	addExtendedResourceAccessors(env, ctx)
+	addSecretEnv(env, ctx)
+	addI18nEnv(env, ctx)
+	addLockEnv(env, ctx)
+	addConstEnv(env, ctx)
+	addSessionLifecycleEnv(env, ctx)
+	addFormatEnv(env)
+	addModelEnv(env, ctx)
 	addEngineInputEnv(env, ctx)
 	addRichRequestEnv(env, ctx)
 	env["item"] = buildItemAccessorEnv(ctx, false)
@@ -104,7 +204,9 @@ func addBasicRequestEnv(env map[string]interface{}, ctx *ExecutionContext) {
 		"path":           req.Path,
 		"headers":        req.Headers,
 		"query":          req.Query,
+		"params":         req.Params,
 		contextFieldBody: req.Body,
+		"tenant":         req.Tenant,
 	}
 }
 
@@ -154,9 +256,11 @@ func addRichRequestEnv(env map[string]interface{}, ctx *ExecutionContext) {
 		"path":           req.Path,
 		"headers":        req.Headers,
 		"query":          req.Query,
+		"params":         req.Params,
 		contextFieldBody: req.Body,
 		"IP":             req.IP,
 		"ID":             req.ID,
+		"tenant":         req.Tenant,
 		"file": func(name string) interface{} {
 			val, err := ctx.GetRequestFileContent(name)
 			if err != nil {
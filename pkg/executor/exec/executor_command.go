@@ -92,13 +92,11 @@ func (e *Executor) buildCommand(
 ) (*exec.Cmd, *bytes.Buffer, *bytes.Buffer) {
 	kdeps_debug.Log("enter: buildCommand")
 	var cmd *exec.Cmd
-	switch {
-	case len(args) > 0:
+	if len(args) > 0 {
 		cmd = exec.CommandContext(context.Background(), commandStr, args...)
-	case RuntimeOS == "windows":
-		cmd = exec.CommandContext(context.Background(), "cmd", "/C", commandStr)
-	default:
-		cmd = exec.CommandContext(context.Background(), "sh", "-c", commandStr)
+	} else {
+		shell, shellArgs := resolveShell(config.Shell)
+		cmd = exec.CommandContext(context.Background(), shell, append(shellArgs, commandStr)...)
 	}
 
 	var stdout, stderr bytes.Buffer
@@ -111,16 +109,32 @@ func (e *Executor) buildCommand(
 		cmd.Dir = ctx.FSRoot
 	}
 
-	if len(config.Env) > 0 {
-		cmd.Env = os.Environ()
-		for k, v := range config.Env {
-			cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
-		}
+	cmd.Env = executor.SubprocessEnv()
+	for k, v := range config.Env {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
 	}
 
 	return cmd, &stdout, &stderr
 }
 
+// resolveShell maps the configured shell name onto the interpreter and its
+// command flag. Empty falls back to the platform default: cmd on Windows,
+// sh elsewhere.
+func resolveShell(name string) (string, []string) {
+	switch name {
+	case "sh", "bash":
+		return name, []string{"-c"}
+	case "cmd":
+		return "cmd", []string{"/C"}
+	case "powershell", "pwsh":
+		return name, []string{"-NoProfile", "-Command"}
+	}
+	if RuntimeOS == "windows" {
+		return "cmd", []string{"/C"}
+	}
+	return "sh", []string{"-c"}
+}
+
 // formatFullCommand builds the command string used in execution results and logs.
 func (e *Executor) formatFullCommand(commandStr string, args []string) string {
 	kdeps_debug.Log("enter: formatFullCommand")
@@ -32,6 +32,17 @@ type Graph struct {
 	Nodes map[string]*Node
 	// Edges is the map of edges (exported for testing).
 	Edges map[string][]string // actionID -> dependencies
+
+	// timingHints maps actionID -> historical average duration in seconds.
+	// When set, independent resources are scheduled shortest-first so quick
+	// results surface early; without hints the order is lexicographic.
+	timingHints map[string]float64
+}
+
+// SetTimingHints installs historical durations used to order independent
+// resources during topological sort.
+func (g *Graph) SetTimingHints(hints map[string]float64) {
+	g.timingHints = hints
 }
 
 // Node represents a resource in the dependency graph.
@@ -61,16 +72,25 @@ func (g *Graph) AddResource(resource *domain.Resource) error {
 		return fmt.Errorf("duplicate actionID: %s", actionID)
 	}
 
+	deps := resource.Requires
+	// A login pre-step is an implicit dependency: the session must exist
+	// before this request runs.
+	if resource.HTTPClient != nil && resource.HTTPClient.Login != "" {
+		if !containsString(deps, resource.HTTPClient.Login) {
+			deps = append(append([]string{}, deps...), resource.HTTPClient.Login)
+		}
+	}
+
 	// Create node.
 	node := &Node{
 		Resource:     resource,
 		ActionID:     actionID,
-		Dependencies: resource.Requires,
+		Dependencies: deps,
 		Dependents:   []string{},
 	}
 
 	g.Nodes[actionID] = node
-	g.Edges[actionID] = resource.Requires
+	g.Edges[actionID] = deps
 
 	return nil
 }
@@ -119,3 +139,13 @@ func (g *Graph) GetAllNodes() map[string]*Node {
 	kdeps_debug.Log("enter: GetAllNodes")
 	return g.Nodes
 }
+
+// containsString reports whether list contains value.
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
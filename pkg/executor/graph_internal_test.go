@@ -95,3 +95,39 @@ func TestGraph_TopologicalSortSubsetCycle(t *testing.T) {
 	_, err := g.GetExecutionOrder("a")
 	require.Error(t, err)
 }
+
+func TestSortedNodeIDs_TimingHints(t *testing.T) {
+	g := NewGraph()
+	for _, id := range []string{"alpha", "beta", "gamma"} {
+		require.NoError(t, g.AddResource(&domain.Resource{ActionID: id}))
+	}
+
+	// Without hints: lexicographic.
+	assert.Equal(t, []string{"alpha", "beta", "gamma"}, g.sortedNodeIDs())
+
+	// With hints: shortest first, unhinted last.
+	g.SetTimingHints(map[string]float64{"gamma": 0.5, "alpha": 2.0})
+	assert.Equal(t, []string{"gamma", "alpha", "beta"}, g.sortedNodeIDs())
+}
+
+func TestAddResource_LoginImpliesDependency(t *testing.T) {
+	g := NewGraph()
+	require.NoError(t, g.AddResource(&domain.Resource{
+		ActionID:   "login",
+		HTTPClient: &domain.HTTPClientConfig{URL: "https://x/login"},
+	}))
+	require.NoError(t, g.AddResource(&domain.Resource{
+		ActionID:   "api-call",
+		HTTPClient: &domain.HTTPClientConfig{URL: "https://x/api", Login: "login"},
+	}))
+
+	assert.Contains(t, g.Edges["api-call"], "login")
+
+	// Explicit requires entries are not duplicated.
+	require.NoError(t, g.AddResource(&domain.Resource{
+		ActionID:   "second-call",
+		Requires:   []string{"login"},
+		HTTPClient: &domain.HTTPClientConfig{URL: "https://x/api2", Login: "login"},
+	}))
+	assert.Equal(t, []string{"login"}, g.Edges["second-call"])
+}
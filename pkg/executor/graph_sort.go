@@ -20,6 +20,7 @@ package executor
 
 import (
 	"fmt"
+	"sort"
 
 	kdeps_debug "github.com/kdeps/kdeps/v2/pkg/debug"
 	"github.com/kdeps/kdeps/v2/pkg/domain"
@@ -37,7 +38,7 @@ func (g *Graph) TopologicalSort() ([]*domain.Resource, error) {
 func (g *Graph) topologicalSortAllNodes() ([]*domain.Resource, error) {
 	visited := make(map[string]bool)
 	var result []*domain.Resource
-	for actionID := range g.Nodes {
+	for _, actionID := range g.sortedNodeIDs() {
 		if visited[actionID] {
 			continue
 		}
@@ -48,6 +49,29 @@ func (g *Graph) topologicalSortAllNodes() ([]*domain.Resource, error) {
 	return result, nil
 }
 
+// sortedNodeIDs returns all node IDs in deterministic scheduling order:
+// historical duration ascending (shortest first) when timing hints are set,
+// falling back to lexicographic order among unhinted or tied resources.
+func (g *Graph) sortedNodeIDs() []string {
+	ids := make([]string, 0, len(g.Nodes))
+	for actionID := range g.Nodes {
+		ids = append(ids, actionID)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		hintI, okI := g.timingHints[ids[i]]
+		hintJ, okJ := g.timingHints[ids[j]]
+		switch {
+		case okI && okJ && hintI != hintJ:
+			return hintI < hintJ
+		case okI != okJ:
+			return okI // hinted resources schedule before unhinted ones
+		default:
+			return ids[i] < ids[j]
+		}
+	})
+	return ids
+}
+
 // TopologicalSortUtil performs DFS for topological sort (exported for testing).
 func (g *Graph) TopologicalSortUtil(
 	actionID string,
@@ -106,6 +106,12 @@ func (e *Executor) Execute(
 		return nil, err
 	}
 
+	// http://agent.<alias>/... resolves against the supervisor's registry.
+	urlStr, err = executor.RewriteAgentURL(urlStr)
+	if err != nil {
+		return nil, err
+	}
+
 	if resolvedConfig.Cache != nil {
 		if cached, found := e.checkCache(ctx, resolvedConfig.Cache, urlStr, method, headers); found {
 			return cached, nil
@@ -123,6 +129,16 @@ func (e *Executor) Execute(
 		return nil, err
 	}
 
+	if cookiesEnabled(resolvedConfig.UseCookies, resolvedConfig.Login) {
+		client.Jar = runCookieJar(ctx)
+	}
+
+	if isSigV4(auth) {
+		if signErr := e.signSigV4(auth, req); signErr != nil {
+			return nil, signErr
+		}
+	}
+
 	resp, err := e.executeRequestWithRetry(client, req, resolvedConfig.Retry)
 	if err != nil {
 		return map[string]interface{}{
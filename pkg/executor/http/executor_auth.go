@@ -76,6 +76,10 @@ func (e *Executor) handleAuth(
 		}
 		headers["Authorization"] = "Bearer " + token
 
+	case "sigv4":
+		// SigV4 signs the fully built request (it needs method, URL, and
+		// payload hash); see signSigV4 in executor_sigv4.go.
+
 	default:
 		return nil, fmt.Errorf("unsupported auth type: %s", auth.Type)
 	}
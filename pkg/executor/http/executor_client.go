@@ -57,6 +57,9 @@ func (f *DefaultClientFactory) CreateClient(config *domain.HTTPClientConfig, pro
 
 	configureRedirectPolicy(client, config)
 
+	if config.Proxy != "" {
+		proxy = config.Proxy // per-resource proxy beats the connection's
+	}
 	proxyURL := resolveProxyURL(proxy)
 	if proxyURL != "" {
 		if err := configureProxyTransport(client, proxyURL); err != nil {
@@ -90,6 +93,16 @@ func configureRedirectPolicy(client *http.Client, config *domain.HTTPClientConfi
 		}
 		return
 	}
+	if config.MaxRedirects != nil {
+		limit := *config.MaxRedirects
+		client.CheckRedirect = func(_ *http.Request, via []*http.Request) error {
+			if len(via) > limit {
+				return fmt.Errorf("%w: more than %d redirect(s)", ErrTooManyRedirects, limit)
+			}
+			return nil
+		}
+		return
+	}
 	client.CheckRedirect = nil
 }
 
@@ -32,7 +32,6 @@ import (
 	"github.com/kdeps/kdeps/v2/pkg/domain"
 	"github.com/kdeps/kdeps/v2/pkg/executor"
 	"github.com/kdeps/kdeps/v2/pkg/parser/expression"
-	"github.com/kdeps/kdeps/v2/pkg/version"
 )
 
 func (e *Executor) prepareRequest(
@@ -73,9 +72,7 @@ func (e *Executor) prepareRequest(
 		headers[key] = evaluatedValue
 	}
 
-	if _, exists := headers["User-Agent"]; !exists {
-		headers["User-Agent"] = "KDeps/" + version.Version
-	}
+	e.applyOutboundMetadata(ctx, config, headers)
 
 	if auth != nil {
 		authHeaders, authErr := e.handleAuth(auth, evaluator, ctx)
@@ -19,7 +19,6 @@
 package http
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -52,12 +51,18 @@ func readLimitedResponseBody(resp *http.Response, maxBytes int64) ([]byte, error
 		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
 	if maxBytes > 0 && int64(len(respBody)) > maxBytes {
-		return nil, fmt.Errorf("HTTP response exceeds max_response_bytes limit of %d bytes", maxBytes)
+		// The limit applies to the decompressed stream (net/http transparently
+		// gunzips), so a tiny compressed bomb cannot exhaust memory either.
+		return nil, fmt.Errorf("%w: response exceeds %d bytes", ErrResponseTooLarge, maxBytes)
 	}
 	return respBody, nil
 }
 
-func (e *Executor) formatHTTPResponse(resp *http.Response, respBody []byte) map[string]interface{} {
+func (e *Executor) formatHTTPResponse(
+	resp *http.Response,
+	respBody []byte,
+	parseAs string,
+) map[string]interface{} {
 	kdeps_debug.Log("enter: formatHTTPResponse")
 	response := map[string]interface{}{
 		"statusCode": resp.StatusCode,
@@ -66,9 +71,8 @@ func (e *Executor) formatHTTPResponse(resp *http.Response, respBody []byte) map[
 		"body":       string(respBody),
 	}
 
-	var jsonBody interface{}
-	if unmarshalErr := json.Unmarshal(respBody, &jsonBody); unmarshalErr == nil {
-		response["data"] = jsonBody
+	if data, ok := parseResponseData(resp.Header.Get("Content-Type"), parseAs, respBody); ok {
+		response["data"] = data
 	}
 
 	return response
@@ -82,12 +86,19 @@ func (e *Executor) processResponse(
 	headers map[string]string,
 ) (interface{}, error) {
 	kdeps_debug.Log("enter: processResponse")
-	respBody, err := readLimitedResponseBody(resp, resolveMaxResponseBytes())
+	if typeErr := checkContentType(resp, config.AcceptContentTypes); typeErr != nil {
+		return nil, typeErr
+	}
+	maxBytes := resolveMaxResponseBytes()
+	if config.MaxResponseBytes > 0 {
+		maxBytes = config.MaxResponseBytes
+	}
+	respBody, err := readLimitedResponseBody(resp, maxBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	response := e.formatHTTPResponse(resp, respBody)
+	response := e.formatHTTPResponse(resp, respBody, config.ParseAs)
 
 	if config.Cache != nil {
 		e.cacheResponse(ctx, config.Cache, urlStr, method, headers, response)
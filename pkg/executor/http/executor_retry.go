@@ -57,7 +57,7 @@ func (e *Executor) executeRequestWithRetry(
 				time.Sleep(e.calculateBackoff(retryConfig, attempt))
 				continue
 			}
-			return nil, fmt.Errorf("HTTP request failed: %w", err)
+			return nil, fmt.Errorf("HTTP request failed: %w", classifyRequestError(err))
 		}
 
 		if attempt < maxAttempts && e.shouldRetryOnStatus(retryConfig, resp.StatusCode) {
@@ -72,7 +72,7 @@ func (e *Executor) executeRequestWithRetry(
 		return resp, nil
 	}
 
-	return nil, fmt.Errorf("HTTP request failed after all retries: %w", lastErr)
+	return nil, fmt.Errorf("HTTP request failed after all retries: %w", classifyRequestError(lastErr))
 }
 
 func (e *Executor) shouldRetry(retry *domain.RetryConfig, _ error) bool {
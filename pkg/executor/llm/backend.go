@@ -65,4 +65,12 @@ type ChatRequestConfig struct {
 	JSONResponse  bool
 	Streaming     bool
 	Tools         []map[string]interface{}
+
+	// Sampling parameters, passed through to backends that support them.
+	// Nil pointers mean "backend default".
+	Temperature *float64
+	TopP        *float64
+	Seed        *int
+	MaxTokens   *int
+	StopWords   []string
 }
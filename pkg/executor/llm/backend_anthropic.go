@@ -57,6 +57,19 @@ func (b *AnthropicBackend) BuildRequest(
 	if config.ContextLength > 0 {
 		req["max_tokens"] = config.ContextLength
 	}
+	if config.MaxTokens != nil {
+		req["max_tokens"] = *config.MaxTokens
+	}
+	if config.Temperature != nil {
+		req["temperature"] = *config.Temperature
+	}
+	if config.TopP != nil {
+		req["top_p"] = *config.TopP
+	}
+	if len(config.StopWords) > 0 {
+		req["stop_sequences"] = config.StopWords
+	}
+	// Anthropic has no seed parameter; config.Seed is intentionally ignored.
 
 	// Anthropic doesn't support JSON response format in the same way
 	// but we can add it if needed via system message or other means
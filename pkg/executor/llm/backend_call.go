@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"fmt"
 	stdhttp "net/http"
+	"os"
 	"time"
 
 	kdeps_debug "github.com/kdeps/kdeps/v2/pkg/debug"
@@ -67,7 +68,11 @@ func newBackendPostRequest(
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("User-Agent", "KDeps/"+version.Version)
+	userAgent := os.Getenv("KDEPS_USER_AGENT")
+	if userAgent == "" {
+		userAgent = "KDeps/" + version.Version
+	}
+	req.Header.Set("User-Agent", userAgent)
 	return req, nil
 }
 
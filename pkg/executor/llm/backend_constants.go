@@ -26,5 +26,7 @@ const (
 	backendMaritaca    = "maritaca"
 	backendErnie       = "ernie"
 	backendBedrock     = "bedrock"
+	backendAzure       = "azure"
+	backendVertex      = "vertex"
 	backendWatsonX     = "watsonx"
 )
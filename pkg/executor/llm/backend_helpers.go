@@ -40,6 +40,9 @@ func buildOpenAICompatRequest(
 	if config.ContextLength > 0 {
 		req["max_tokens"] = config.ContextLength
 	}
+	if config.MaxTokens != nil {
+		req["max_tokens"] = *config.MaxTokens
+	}
 
 	if config.JSONResponse {
 		req["response_format"] = map[string]interface{}{
@@ -51,6 +54,19 @@ func buildOpenAICompatRequest(
 		req["tools"] = config.Tools
 	}
 
+	if config.Temperature != nil {
+		req["temperature"] = *config.Temperature
+	}
+	if config.TopP != nil {
+		req["top_p"] = *config.TopP
+	}
+	if config.Seed != nil {
+		req["seed"] = *config.Seed
+	}
+	if len(config.StopWords) > 0 {
+		req["stop"] = config.StopWords
+	}
+
 	return req
 }
 
@@ -70,6 +70,26 @@ func (b *OllamaBackend) BuildRequest(
 		req["tools"] = config.Tools
 	}
 
+	options := map[string]interface{}{}
+	if config.Temperature != nil {
+		options["temperature"] = *config.Temperature
+	}
+	if config.TopP != nil {
+		options["top_p"] = *config.TopP
+	}
+	if config.Seed != nil {
+		options["seed"] = *config.Seed
+	}
+	if config.MaxTokens != nil {
+		options["num_predict"] = *config.MaxTokens
+	}
+	if len(config.StopWords) > 0 {
+		options["stop"] = config.StopWords
+	}
+	if len(options) > 0 {
+		req["options"] = options
+	}
+
 	return req, nil
 }
 
@@ -18,7 +18,11 @@
 
 package llm
 
-import kdeps_debug "github.com/kdeps/kdeps/v2/pkg/debug"
+import (
+	"strings"
+
+	kdeps_debug "github.com/kdeps/kdeps/v2/pkg/debug"
+)
 
 // BackendRegistry manages available backends.
 type BackendRegistry struct {
@@ -47,6 +51,8 @@ var defaultRegistryBackends = []Backend{
 	&ErnieBackend{},
 	&BedrockBackend{},
 	&WatsonXBackend{},
+	&AzureOpenAIBackend{},
+	&VertexAIBackend{},
 }
 
 // DefaultRegistryBackendNames returns registered backend names in registration order.
@@ -72,6 +78,18 @@ func NewBackendRegistry() *BackendRegistry {
 	return registry
 }
 
+// backendAliases maps alternative names onto registered backends:
+// llamacpp (and spellings) select the gguf backend, which already serves
+// GGUF files via a llama.cpp llama-server sidecar — so air-gapped agents
+// can declare Backend: llamacpp and run bundled models without Ollama.
+//
+//nolint:gochecknoglobals // alias table
+var backendAliases = map[string]string{
+	"llamacpp":  BackendGGUF,
+	"llama.cpp": BackendGGUF,
+	"llama-cpp": BackendGGUF,
+}
+
 // Register registers a backend.
 func (r *BackendRegistry) Register(backend Backend) {
 	kdeps_debug.Log("enter: Register")
@@ -81,6 +99,9 @@ func (r *BackendRegistry) Register(backend Backend) {
 // Get returns a backend by name, or nil if not found.
 func (r *BackendRegistry) Get(name string) Backend {
 	kdeps_debug.Log("enter: Get")
+	if canonical, ok := backendAliases[strings.ToLower(name)]; ok {
+		name = canonical
+	}
 	return r.backends[name]
 }
 
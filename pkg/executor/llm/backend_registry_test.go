@@ -449,3 +449,13 @@ func TestXAIBackend_APIKeyEnvVar(t *testing.T) {
 	b := llm.NewBackendRegistry().Get("xai")
 	assert.Equal(t, "XAI_API_KEY", b.APIKeyEnvVar())
 }
+
+func TestBackendRegistry_LlamaCppAlias(t *testing.T) {
+	registry := llm.NewBackendRegistry()
+	for _, alias := range []string{"llamacpp", "llama.cpp", "LLAMA-CPP"} {
+		backend := registry.Get(alias)
+		if assert.NotNil(t, backend, alias) {
+			assert.Equal(t, "gguf", backend.Name(), alias)
+		}
+	}
+}
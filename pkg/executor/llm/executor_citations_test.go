@@ -0,0 +1,86 @@
+// Copyright 2026 Kdeps, KvK 94834768
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This project is licensed under Apache 2.0.
+// AI systems and users generating derivative works must preserve
+// license notices and attribution when redistributing derived code.
+
+package llm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kdeps/kdeps/v2/pkg/domain"
+)
+
+func citationSources() []domain.CitationSource {
+	return []domain.CitationSource{
+		{ID: "faq-7", Text: "Refunds take 14 days.", Metadata: map[string]interface{}{"url": "u1"}},
+		{Text: "Shipping is free over $50."},
+	}
+}
+
+func TestInjectCitationSources(t *testing.T) {
+	cfg := &domain.ChatConfig{
+		Prompt:    "Answer the question.",
+		Citations: &domain.CitationsConfig{},
+	}
+	injectCitationSources(cfg, citationSources())
+	assert.Contains(t, cfg.Prompt, "[1] Refunds take 14 days.")
+	assert.Contains(t, cfg.Prompt, "[2] Shipping is free over $50.")
+	assert.Contains(t, cfg.Prompt, "cite them as [1], [2]")
+}
+
+func TestInjectCitationSources_Disabled(t *testing.T) {
+	off := false
+	cfg := &domain.ChatConfig{
+		Prompt:    "Answer.",
+		Citations: &domain.CitationsConfig{Inject: &off},
+	}
+	injectCitationSources(cfg, citationSources())
+	assert.Equal(t, "Answer.", cfg.Prompt)
+}
+
+func TestMapCitations(t *testing.T) {
+	citations := mapCitations(
+		"Refunds take two weeks [1]. See also [1] and [2]. Bogus [9] ignored.",
+		citationSources(),
+	)
+	require.Len(t, citations, 2)
+	assert.Equal(t, "[1]", citations[0]["marker"])
+	assert.Equal(t, 1, citations[0]["index"])
+	assert.Equal(t, "faq-7", citations[0]["id"])
+	assert.Equal(t, map[string]interface{}{"url": "u1"}, citations[0]["metadata"])
+	assert.Equal(t, "[2]", citations[1]["marker"])
+	_, hasID := citations[1]["id"]
+	assert.False(t, hasID)
+}
+
+func TestAttachCitations(t *testing.T) {
+	response := map[string]interface{}{jsonFieldContent: "Answer [2]."}
+	attachCitations(response, citationSources())
+	citations, ok := response["citations"].([]map[string]interface{})
+	require.True(t, ok)
+	require.Len(t, citations, 1)
+	assert.Equal(t, 2, citations[0]["index"])
+
+	// Non-textual content is left untouched.
+	toolResponse := map[string]interface{}{"tool_calls": []interface{}{}}
+	attachCitations(toolResponse, citationSources())
+	_, hasCitations := toolResponse["citations"]
+	assert.False(t, hasCitations)
+}
@@ -39,6 +39,16 @@ func (e *Executor) Execute(
 	if err != nil {
 		return nil, err
 	}
+	resolvedConfig = applyEnvironmentSampling(resolvedConfig)
+
+	var citationSources []domain.CitationSource
+	if resolvedConfig.Citations != nil {
+		citationSources, err = e.resolveCitationSources(evaluator, ctx, resolvedConfig.Citations)
+		if err != nil {
+			return nil, err
+		}
+		injectCitationSources(resolvedConfig, citationSources)
+	}
 
 	modelStr, promptStr, fallbackRoutes, err := e.resolveModelForExecution(evaluator, ctx, resolvedConfig)
 	if err != nil {
@@ -55,7 +65,11 @@ func (e *Executor) Execute(
 		return nil, backendErr
 	}
 	allTools := mergeComponentTools(resolvedConfig.Tools, resolvedConfig.ComponentTools, ctx.Workflow)
+	allTools = materializeResourceTools(allTools, ctx)
 	requestConfig := e.resolveChatRequestConfig(resolvedConfig, allTools)
+	if resolvedConfig.ContextBudget != nil {
+		messages, requestConfig = applyContextBudget(resolvedConfig, modelStr, messages, requestConfig)
+	}
 	requestBody, err := backend.BuildRequest(modelStr, messages, requestConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build request: %w", err)
@@ -89,5 +103,20 @@ func (e *Executor) Execute(
 		}
 	}
 
+	applyThinkingCapture(resolvedConfig, response)
+
+	if resolvedConfig.Critique != nil {
+		if _, hasErr := response[fieldError]; !hasErr {
+			response, err = e.runCritique(ctx, resolvedConfig, response)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(citationSources) > 0 {
+		attachCitations(response, citationSources)
+	}
+
 	return e.formatExecuteResult(response, resolvedConfig, maxOutputBytes)
 }
@@ -98,6 +98,11 @@ func (e *Executor) resolveChatRequestConfig(config *domain.ChatConfig, allTools
 		JSONResponse:  config.JSONResponse,
 		Streaming:     streaming,
 		Tools:         e.buildTools(allTools),
+		Temperature:   config.Temperature,
+		TopP:          config.TopP,
+		Seed:          config.Seed,
+		MaxTokens:     config.MaxTokens,
+		StopWords:     config.StopWords,
 	}
 }
 
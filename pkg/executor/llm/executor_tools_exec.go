@@ -33,7 +33,6 @@ func (e *Executor) executeToolCalls(
 	ctx *executor.ExecutionContext,
 ) ([]map[string]interface{}, error) {
 	kdeps_debug.Log("enter: executeToolCalls")
-	results := make([]map[string]interface{}, 0, len(toolCalls))
 
 	// Create tool name to definition map
 	toolMap := make(map[string]domain.Tool)
@@ -41,37 +40,27 @@ func (e *Executor) executeToolCalls(
 		toolMap[tool.Name] = tool
 	}
 
-	for _, toolCall := range toolCalls {
-		toolName, arguments, toolCallID, ok := parseToolCallFunction(toolCall)
-		if !ok {
-			continue
-		}
+	indexed := make([]map[string]interface{}, len(toolCalls))
+	run := func(i int, toolCall map[string]interface{}) {
+		indexed[i] = e.executeOneToolCall(toolCall, toolMap, ctx)
+	}
 
-		toolDef, exists := toolMap[toolName]
-		if !exists {
-			results = append(results, map[string]interface{}{
-				"tool_call_id": toolCallID,
-				fieldName:      toolName,
-				fieldError:     fmt.Sprintf("tool '%s' not found", toolName),
-			})
-			continue
+	// Multiple self-contained tool calls in one turn execute concurrently
+	// (bounded); resource-script tools share the execution context and stay
+	// sequential. Result ordering is positional either way.
+	if len(toolCalls) > 1 && allSelfContainedTools(toolCalls, toolMap) {
+		runToolCallsBounded(toolCalls, run)
+	} else {
+		for i, toolCall := range toolCalls {
+			run(i, toolCall)
 		}
+	}
 
-		result, execErr := e.executeTool(toolDef, arguments, ctx)
-		if execErr != nil {
-			results = append(results, map[string]interface{}{
-				"tool_call_id": toolCallID,
-				fieldName:      toolName,
-				fieldError:     execErr.Error(),
-			})
-			continue
+	results := make([]map[string]interface{}, 0, len(toolCalls))
+	for _, result := range indexed {
+		if result != nil {
+			results = append(results, result)
 		}
-
-		results = append(results, map[string]interface{}{
-			"tool_call_id":   toolCallID,
-			fieldName:        toolName,
-			jsonFieldContent: result,
-		})
 	}
 
 	if executeToolCallsErrInjector != nil {
@@ -82,6 +71,57 @@ func (e *Executor) executeToolCalls(
 	return results, nil
 }
 
+// executeOneToolCall runs a single tool call, returning its result entry
+// (nil for unparsable calls).
+func (e *Executor) executeOneToolCall(
+	toolCall map[string]interface{},
+	toolMap map[string]domain.Tool,
+	ctx *executor.ExecutionContext,
+) map[string]interface{} {
+	toolName, arguments, toolCallID, ok := parseToolCallFunction(toolCall)
+	if !ok {
+		return nil
+	}
+
+	toolDef, exists := toolMap[toolName]
+	if !exists {
+		return map[string]interface{}{
+			"tool_call_id": toolCallID,
+			fieldName:      toolName,
+			fieldError:     fmt.Sprintf("tool '%s' not found", toolName),
+		}
+	}
+
+	if toolDef.Memoize {
+		if cached, hit := cachedToolResult(ctx, toolName, arguments); hit {
+			return map[string]interface{}{
+				"tool_call_id":   toolCallID,
+				fieldName:        toolName,
+				jsonFieldContent: cached,
+				"cached":         true,
+			}
+		}
+	}
+
+	result, execErr := e.executeTool(toolDef, arguments, ctx)
+	if execErr != nil {
+		return map[string]interface{}{
+			"tool_call_id": toolCallID,
+			fieldName:      toolName,
+			fieldError:     execErr.Error(),
+		}
+	}
+	if toolDef.Memoize {
+		storeToolResult(ctx, toolName, arguments, result)
+	}
+
+	return map[string]interface{}{
+		"tool_call_id":   toolCallID,
+		fieldName:        toolName,
+		jsonFieldContent: result,
+	}
+}
+
 func parseToolCallFunction(toolCall map[string]interface{}) (string, string, interface{}, bool) {
 	function, okFunc := toolCall[fieldFunction].(map[string]interface{})
 	if !okFunc {
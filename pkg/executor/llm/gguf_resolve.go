@@ -44,6 +44,15 @@ func (m *GGUFManager) resolveLocalModel(model string) (string, error) {
 	if filepath.IsAbs(model) {
 		return m.resolveExistingPath(model)
 	}
+	// A slash-containing .gguf path is a bundled model inside the agent
+	// package (e.g. "data/model.gguf"), resolved relative to the run dir.
+	if strings.HasSuffix(model, ".gguf") && strings.Contains(model, "/") {
+		abs, err := filepathAbsFunc(model)
+		if err != nil {
+			return "", fmt.Errorf("cannot resolve bundled model path %s: %w", model, err)
+		}
+		return m.resolveExistingPath(abs)
+	}
 	if strings.HasPrefix(model, "./") || strings.HasPrefix(model, "../") {
 		abs, err := filepathAbsFunc(model)
 		if err != nil {
@@ -32,6 +32,7 @@ import (
 	kdepsconfig "github.com/kdeps/kdeps/v2/pkg/config"
 	kdeps_debug "github.com/kdeps/kdeps/v2/pkg/debug"
 	"github.com/kdeps/kdeps/v2/pkg/domain"
+	"github.com/kdeps/kdeps/v2/pkg/executor"
 )
 
 // parseTimeout parses the timeout: resource > KDEPS_PYTHON_TIMEOUT > DefaultPythonTimeout.
@@ -99,7 +100,7 @@ func (e *Executor) executeScript(
 	kdeps_debug.Log("enter: executeScript")
 	var stdout, stderr bytes.Buffer
 	cmd := e.buildPythonCommand(pythonPath, scriptContent, scriptFile, args)
-	cmd.Env = append(os.Environ(), "VIRTUAL_ENV="+venvPath)
+	cmd.Env = append(executor.SubprocessEnv(), "VIRTUAL_ENV="+venvPath)
 	cmd.Dir = workDir
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
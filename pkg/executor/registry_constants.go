@@ -49,5 +49,12 @@ const (
 	ExecutorLoader      = "loader"
 	ExecutorVectorStore = "vectorStore"
 	ExecutorTranscribe  = "transcribe"
+	ExecutorDocs        = "docs"
+	ExecutorPayments    = "payments"
+	ExecutorSMS         = "sms"
+	ExecutorPush        = "push"
+	ExecutorRerank      = "rerank"
+	ExecutorOrchestrate = "orchestrate"
+	ExecutorPlugin      = "plugin"
 	ExecutorAPIResponse = "apiResponse"
 )
@@ -91,3 +91,18 @@ func (r *Registry) SetTranscribeExecutor(exec ResourceExecutor) {
 func (r *Registry) GetTranscribeExecutor() ResourceExecutor {
 	return r.getExecutor(ExecutorTranscribe)
 }
+
+func (r *Registry) SetDocsExecutor(exec ResourceExecutor) { r.Register(ExecutorDocs, exec) }
+func (r *Registry) GetDocsExecutor() ResourceExecutor     { return r.getExecutor(ExecutorDocs) }
+
+func (r *Registry) SetPaymentsExecutor(exec ResourceExecutor) { r.Register(ExecutorPayments, exec) }
+func (r *Registry) GetPaymentsExecutor() ResourceExecutor     { return r.getExecutor(ExecutorPayments) }
+
+func (r *Registry) SetSMSExecutor(exec ResourceExecutor) { r.Register(ExecutorSMS, exec) }
+func (r *Registry) GetSMSExecutor() ResourceExecutor     { return r.getExecutor(ExecutorSMS) }
+
+func (r *Registry) SetPushExecutor(exec ResourceExecutor) { r.Register(ExecutorPush, exec) }
+func (r *Registry) GetPushExecutor() ResourceExecutor     { return r.getExecutor(ExecutorPush) }
+
+func (r *Registry) SetRerankExecutor(exec ResourceExecutor) { r.Register(ExecutorRerank, exec) }
+func (r *Registry) GetRerankExecutor() ResourceExecutor     { return r.getExecutor(ExecutorRerank) }
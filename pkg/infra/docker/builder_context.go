@@ -79,6 +79,12 @@ func (b *Builder) CreateBuildContext(
 		}
 	}
 
+	for name, content := range b.ExtraContextFiles {
+		if addErr := b.addFileToTar(tw, name, content); addErr != nil {
+			return nil, fmt.Errorf("failed to add %s: %w", name, addErr)
+		}
+	}
+
 	closeErr := tw.Close()
 	if CloseTarWriterHook != nil {
 		closeErr = CloseTarWriterHook()
@@ -27,6 +27,7 @@ import (
 	"os"
 	"strings"
 
+	kdepsconfig "github.com/kdeps/kdeps/v2/pkg/config"
 	kdeps_debug "github.com/kdeps/kdeps/v2/pkg/debug"
 	"github.com/kdeps/kdeps/v2/pkg/domain"
 )
@@ -72,12 +73,13 @@ type DockerfileData struct {
 
 // Builder builds Docker images from workflows.
 type Builder struct {
-	Client              *Client
+	Client              ContainerRuntime
 	BaseOS              string                 // Base OS: alpine (CPU) or ubuntu (CPU/GPU)
 	GPUType             string                 // GPU type: "", "cuda", "rocm", "intel", "vulkan"
 	PrepackagedBinaries map[string]string      // goarch → temp file path (e.g., "amd64" → "/tmp/kdeps-amd64-xxx")
 	ExecutableFunc      func() (string, error) // For testing: override os.Executable
 	Compiler            Compiler               // For testing: override cross-compilation
+	ExtraContextFiles   map[string][]byte      // extra files added to the build context (e.g. sbom.json)
 }
 
 const (
@@ -85,10 +87,16 @@ const (
 	DefaultFilePermissions = 0644
 )
 
-// NewBuilderWithOS creates a new Docker builder with specified base OS.
+// NewBuilderWithOS creates a new container image builder with the specified
+// base OS, using the runtime configured via container_runtime /
+// KDEPS_CONTAINER_RUNTIME (Docker daemon, podman, or nerdctl).
 func NewBuilderWithOS(baseOS string) (*Builder, error) {
 	kdeps_debug.Log("enter: NewBuilderWithOS")
-	client, err := NewClient()
+	runtimeName := ""
+	if cfg, cfgErr := kdepsconfig.Load(); cfgErr == nil {
+		runtimeName = cfg.ContainerRuntime
+	}
+	containerRuntime, err := NewRuntime(runtimeName)
 	if err != nil {
 		return nil, err
 	}
@@ -98,7 +106,7 @@ func NewBuilderWithOS(baseOS string) (*Builder, error) {
 	}
 
 	return &Builder{
-		Client:   client,
+		Client:   containerRuntime,
 		BaseOS:   baseOS,
 		Compiler: &DefaultCompiler{},
 	}, nil
@@ -125,6 +133,21 @@ func (b *Builder) Build(workflow *domain.Workflow, _ string, noCache bool) (stri
 		return "", fmt.Errorf("failed to generate Dockerfile: %w", err)
 	}
 
+	// Generate the supply-chain SBOM and bake it into the image so deployed
+	// agents can be audited (/admin/sbom). Failures only warn: an image
+	// without an SBOM beats no image.
+	if templateData, dataErr := b.buildTemplateData(workflow); dataErr == nil {
+		if sbom, sbomErr := b.GenerateSBOM(workflow, templateData); sbomErr == nil {
+			if b.ExtraContextFiles == nil {
+				b.ExtraContextFiles = map[string][]byte{}
+			}
+			b.ExtraContextFiles["sbom.json"] = sbom
+			dockerfile += "\nCOPY sbom.json " + SBOMPath + "\n"
+		} else {
+			fmt.Fprintf(os.Stderr, "Warning: SBOM generation failed: %v\n", sbomErr)
+		}
+	}
+
 	// Create build context
 	buildContext, err := b.CreateBuildContext(workflow, dockerfile)
 	if err != nil {
@@ -0,0 +1,118 @@
+// Copyright 2026 Kdeps, KvK 94834768
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kdepsconfig "github.com/kdeps/kdeps/v2/pkg/config"
+	"github.com/kdeps/kdeps/v2/pkg/domain"
+)
+
+func TestMaskSecrets(t *testing.T) {
+	payload := map[string]interface{}{
+		"api_auth_token": "super-secret",
+		"defaults":       map[string]interface{}{"timezone": "UTC"},
+		"smtp_connections": map[string]interface{}{
+			"main": map[string]interface{}{
+				"host":     "smtp.example.com",
+				"password": "hunter2",
+			},
+		},
+		"entries": []interface{}{
+			map[string]interface{}{"secretKey": "sk_live_123", "label": "x"},
+		},
+	}
+	maskSecrets(payload)
+	assert.Equal(t, maskedValue, payload["api_auth_token"])
+	smtp := payload["smtp_connections"].(map[string]interface{})["main"].(map[string]interface{})
+	assert.Equal(t, "smtp.example.com", smtp["host"])
+	assert.Equal(t, maskedValue, smtp["password"])
+	entry := payload["entries"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, maskedValue, entry["secretKey"])
+	assert.Equal(t, "x", entry["label"])
+}
+
+func TestHandleAdminConfig_MasksSecrets(t *testing.T) {
+	orig := loadConfigForAdmin
+	defer func() { loadConfigForAdmin = orig }()
+	loadConfigForAdmin = func() (*kdepsconfig.Config, error) {
+		return &kdepsconfig.Config{APIAuthToken: "tok123"}, nil
+	}
+
+	server, err := NewServer(&domain.Workflow{}, &versionRecordingExecutor{}, nil)
+	require.NoError(t, err)
+	rec := httptest.NewRecorder()
+	server.HandleAdminConfig(rec, httptest.NewRequest(stdhttp.MethodGet, "/admin/config", nil))
+	require.Equal(t, stdhttp.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "tok123")
+	assert.Contains(t, rec.Body.String(), maskedValue)
+}
+
+func TestHandleAdminRoutes(t *testing.T) {
+	server, err := NewServer(&domain.Workflow{}, &versionRecordingExecutor{}, nil)
+	require.NoError(t, err)
+	server.SetupRoutes()
+
+	rec := httptest.NewRecorder()
+	server.HandleAdminRoutes(rec, httptest.NewRequest(stdhttp.MethodGet, "/admin/routes", nil))
+	require.Equal(t, stdhttp.StatusOK, rec.Code)
+
+	var payload struct {
+		Routes map[string][]string `json:"routes"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &payload))
+	assert.Contains(t, payload.Routes, "/health")
+	assert.Contains(t, payload.Routes, "/admin/config")
+}
+
+func TestDialHealth(t *testing.T) {
+	orig := dialConnection
+	defer func() { dialConnection = orig }()
+
+	assert.Equal(t, "unconfigured", dialHealth("", 25))
+	assert.Equal(t, "unchecked", dialHealth("smtp.example.com", 0))
+
+	dialConnection = func(string) error { return nil }
+	assert.Equal(t, "ok", dialHealth("smtp.example.com", 25))
+
+	dialConnection = func(string) error { return errors.New("refused") }
+	assert.Equal(t, "unreachable", dialHealth("smtp.example.com", 25))
+}
+
+func TestHandleAdminModels_FromWorkflow(t *testing.T) {
+	orig := loadConfigForAdmin
+	defer func() { loadConfigForAdmin = orig }()
+	loadConfigForAdmin = func() (*kdepsconfig.Config, error) {
+		return &kdepsconfig.Config{}, nil
+	}
+
+	workflow := &domain.Workflow{Resources: []*domain.Resource{
+		{ActionID: "a", Chat: &domain.ChatConfig{Model: "llama3.2:3b"}},
+		{ActionID: "b", Chat: &domain.ChatConfig{Model: "llama3.2:3b"}},
+		{ActionID: "c", Exec: &domain.ExecConfig{}},
+	}}
+	server, err := NewServer(workflow, &versionRecordingExecutor{}, nil)
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	server.HandleAdminModels(rec, httptest.NewRequest(stdhttp.MethodGet, "/admin/models", nil))
+	var payload struct {
+		Models []string `json:"models"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &payload))
+	assert.Equal(t, []string{"llama3.2:3b"}, payload.Models)
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 Kdeps, KvK 94834768
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+package http
+
+import (
+	stdhttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kdeps/kdeps/v2/pkg/domain"
+)
+
+func TestRequireAdminAuth(t *testing.T) {
+	handler := requireAdminAuth(func(w stdhttp.ResponseWriter, _ *stdhttp.Request) {
+		w.WriteHeader(stdhttp.StatusOK)
+	})
+
+	// Disabled without a token.
+	t.Setenv(adminAuthEnvVar, "")
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(stdhttp.MethodPost, "/admin/reload", nil))
+	assert.Equal(t, stdhttp.StatusForbidden, rec.Code)
+
+	// Wrong token rejected.
+	t.Setenv(adminAuthEnvVar, "secret")
+	rec = httptest.NewRecorder()
+	req := httptest.NewRequest(stdhttp.MethodPost, "/admin/reload", nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+	handler(rec, req)
+	assert.Equal(t, stdhttp.StatusUnauthorized, rec.Code)
+
+	// Correct token passes through.
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(stdhttp.MethodPost, "/admin/reload", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	handler(rec, req)
+	assert.Equal(t, stdhttp.StatusOK, rec.Code)
+}
+
+func TestHandleAdminReload_NoParserRollsBack(t *testing.T) {
+	original := &domain.Workflow{Metadata: domain.WorkflowMetadata{Name: "blue"}}
+	server, err := NewServer(original, &versionRecordingExecutor{}, nil)
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	server.HandleAdminReload(rec, httptest.NewRequest(stdhttp.MethodPost, "/admin/reload", nil))
+	assert.Equal(t, stdhttp.StatusUnprocessableEntity, rec.Code)
+	assert.Contains(t, rec.Body.String(), "rolled_back")
+	assert.Same(t, original, server.lockedWorkflow())
+}
+
+func TestWarmWorkflow_FailurePreventsSwitch(t *testing.T) {
+	original := &domain.Workflow{Metadata: domain.WorkflowMetadata{Name: "blue"}}
+	server, err := NewServer(original, &failingExecutor{}, nil)
+	require.NoError(t, err)
+
+	green := &domain.Workflow{Metadata: domain.WorkflowMetadata{Name: "green"}}
+	warmErr := server.warmWorkflow(green, map[string]interface{}{"ping": true})
+	assert.Error(t, warmErr)
+	assert.Same(t, original, server.lockedWorkflow())
+}
+
+// failingExecutor always errors, standing in for a broken green workflow.
+type failingExecutor struct{}
+
+func (failingExecutor) Execute(*domain.Workflow, interface{}) (interface{}, error) {
+	return nil, assert.AnError
+}
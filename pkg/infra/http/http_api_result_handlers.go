@@ -67,6 +67,15 @@ func (s *Server) writeAPISuccessResponse(
 
 	applySessionCookieIfPresent(w, r)
 
+	data = applyFieldSelection(r, s.Workflow, parseJSONStringPayload(data))
+
+	// Accept-header negotiation: non-JSON formats serialize the evaluated
+	// document directly (no success/meta envelope).
+	if format := negotiateResponseFormat(r, s.Workflow); format != responseFormatJSON {
+		s.writeNegotiatedAPIResponse(w, r, data, format)
+		return
+	}
+
 	respContentType := defaultAPIResponseContentType(w)
 
 	if !isJSONAPIContentType(respContentType) {
@@ -77,6 +86,23 @@ func (s *Server) writeAPISuccessResponse(
 	s.writeJSONAPIResponse(w, r, data, meta)
 }
 
+// writeNegotiatedAPIResponse serializes data into the negotiated format.
+func (s *Server) writeNegotiatedAPIResponse(
+	w stdhttp.ResponseWriter,
+	r *stdhttp.Request,
+	data interface{},
+	format string,
+) {
+	payload, contentType, marshalErr := marshalNegotiatedPayload(parseJSONStringPayload(data), format)
+	if marshalErr != nil {
+		s.respondMarshalError(w, r, marshalErr, apiResponseMarshalLabel)
+		return
+	}
+	setResponseContentType(w, contentType)
+	s.logWritingRawAPIResponse(r, len(payload), contentType)
+	s.writeRawSuccessResponseBytes(w, r, payload, "failed to write negotiated API response")
+}
+
 func (s *Server) writeRawAPIResponse(
 	w stdhttp.ResponseWriter,
 	r *stdhttp.Request,
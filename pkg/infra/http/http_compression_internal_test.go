@@ -0,0 +1,118 @@
+package http
+
+import (
+	"compress/gzip"
+	"io"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kdeps/kdeps/v2/pkg/domain"
+)
+
+func compressionServer(cfg *domain.CompressionConfig) *Server {
+	return &Server{Workflow: &domain.Workflow{Settings: domain.WorkflowSettings{
+		APIServer: &domain.APIServerConfig{Compression: cfg},
+	}}}
+}
+
+func runCompression(
+	t *testing.T,
+	s *Server,
+	acceptEncoding string,
+	handler stdhttp.HandlerFunc,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	r := httptest.NewRequest(stdhttp.MethodGet, "/api/data", nil)
+	if acceptEncoding != "" {
+		r.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	w := httptest.NewRecorder()
+	s.CompressionMiddleware(handler)(w, r)
+	return w
+}
+
+func TestCompressionMiddlewareCompressesLargeBodies(t *testing.T) {
+	body := strings.Repeat("kdeps ", 400) // well above the 1KiB default
+	w := runCompression(t, compressionServer(nil), "gzip, br",
+		func(w stdhttp.ResponseWriter, _ *stdhttp.Request) {
+			w.Header().Set(headerContentType, "application/json")
+			_, _ = w.Write([]byte(body))
+		})
+
+	assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+
+	reader, err := gzip.NewReader(w.Body)
+	require.NoError(t, err)
+	decompressed, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, body, string(decompressed))
+}
+
+func TestCompressionMiddlewareSkipsSmallBodies(t *testing.T) {
+	w := runCompression(t, compressionServer(nil), "gzip",
+		func(w stdhttp.ResponseWriter, _ *stdhttp.Request) {
+			_, _ = w.Write([]byte("tiny"))
+		})
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+	assert.Equal(t, "tiny", w.Body.String())
+}
+
+func TestCompressionMiddlewareSkipsWithoutAcceptEncoding(t *testing.T) {
+	body := strings.Repeat("x", 2048)
+	w := runCompression(t, compressionServer(nil), "",
+		func(w stdhttp.ResponseWriter, _ *stdhttp.Request) {
+			_, _ = w.Write([]byte(body))
+		})
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+	assert.Equal(t, body, w.Body.String())
+}
+
+func TestCompressionMiddlewareSkipsCompressedTypes(t *testing.T) {
+	w := runCompression(t, compressionServer(nil), "gzip",
+		func(w stdhttp.ResponseWriter, _ *stdhttp.Request) {
+			w.Header().Set(headerContentType, "image/png")
+			_, _ = w.Write([]byte(strings.Repeat("p", 4096)))
+		})
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+}
+
+func TestCompressionMiddlewareRouteOptOut(t *testing.T) {
+	s := compressionServer(&domain.CompressionConfig{DisabledRoutes: []string{"/api/*"}})
+	w := runCompression(t, s, "gzip",
+		func(w stdhttp.ResponseWriter, _ *stdhttp.Request) {
+			_, _ = w.Write([]byte(strings.Repeat("x", 4096)))
+		})
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+}
+
+func TestCompressionMiddlewareDisabled(t *testing.T) {
+	s := compressionServer(&domain.CompressionConfig{Disabled: true})
+	w := runCompression(t, s, "gzip",
+		func(w stdhttp.ResponseWriter, _ *stdhttp.Request) {
+			_, _ = w.Write([]byte(strings.Repeat("x", 4096)))
+		})
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+}
+
+func TestCompressingWriterFlushForcesIdentity(t *testing.T) {
+	w := runCompression(t, compressionServer(nil), "gzip",
+		func(w stdhttp.ResponseWriter, _ *stdhttp.Request) {
+			_, _ = w.Write([]byte("data: chunk\n\n"))
+			w.(stdhttp.Flusher).Flush()
+			_, _ = w.Write([]byte(strings.Repeat("x", 4096)))
+		})
+	assert.Empty(t, w.Header().Get("Content-Encoding"), "streaming responses stay identity")
+}
+
+func TestAlreadyCompressedContentType(t *testing.T) {
+	assert.True(t, alreadyCompressedContentType("image/png"))
+	assert.True(t, alreadyCompressedContentType("application/zip"))
+	assert.False(t, alreadyCompressedContentType("image/svg+xml"))
+	assert.False(t, alreadyCompressedContentType("application/json; charset=utf-8"))
+}
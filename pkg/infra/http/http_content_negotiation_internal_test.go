@@ -0,0 +1,88 @@
+package http
+
+import (
+	stdhttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kdeps/kdeps/v2/pkg/domain"
+)
+
+func negotiationRequest(t *testing.T, accept string) *stdhttp.Request {
+	t.Helper()
+	r := httptest.NewRequest(stdhttp.MethodGet, "/api", nil)
+	if accept != "" {
+		r.Header.Set("Accept", accept)
+	}
+	return r
+}
+
+func TestNegotiateResponseFormat(t *testing.T) {
+	wf := &domain.Workflow{Settings: domain.WorkflowSettings{
+		APIServer: &domain.APIServerConfig{},
+	}}
+
+	assert.Equal(t, responseFormatJSON, negotiateResponseFormat(negotiationRequest(t, ""), wf))
+	assert.Equal(t, responseFormatJSON,
+		negotiateResponseFormat(negotiationRequest(t, "application/json"), wf))
+	assert.Equal(t, responseFormatYAML,
+		negotiateResponseFormat(negotiationRequest(t, "text/yaml"), wf))
+	assert.Equal(t, responseFormatXML,
+		negotiateResponseFormat(negotiationRequest(t, "application/xml;q=0.9"), wf))
+	assert.Equal(t, responseFormatCSV,
+		negotiateResponseFormat(negotiationRequest(t, "text/csv, application/json"), wf))
+	assert.Equal(t, responseFormatMsgpack,
+		negotiateResponseFormat(negotiationRequest(t, "application/msgpack"), wf))
+	assert.Equal(t, responseFormatJSON,
+		negotiateResponseFormat(negotiationRequest(t, "text/html"), wf),
+		"unknown media types fall back")
+}
+
+func TestNegotiateResponseFormatConfiguredDefault(t *testing.T) {
+	wf := &domain.Workflow{Settings: domain.WorkflowSettings{
+		APIServer: &domain.APIServerConfig{DefaultResponseFormat: responseFormatYAML},
+	}}
+	assert.Equal(t, responseFormatYAML, negotiateResponseFormat(negotiationRequest(t, ""), wf))
+	assert.Equal(t, responseFormatYAML, negotiateResponseFormat(negotiationRequest(t, "*/*"), wf))
+	assert.Equal(t, responseFormatCSV,
+		negotiateResponseFormat(negotiationRequest(t, "text/csv"), wf),
+		"explicit Accept overrides the configured default")
+}
+
+func TestMarshalNegotiatedPayloadYAMLAndXML(t *testing.T) {
+	data := map[string]interface{}{"name": "kdeps", "count": 2}
+
+	payload, contentType, err := marshalNegotiatedPayload(data, responseFormatYAML)
+	require.NoError(t, err)
+	assert.Equal(t, "application/yaml", contentType)
+	assert.Contains(t, string(payload), "name: kdeps")
+
+	payload, contentType, err = marshalNegotiatedPayload(data, responseFormatXML)
+	require.NoError(t, err)
+	assert.Equal(t, "application/xml", contentType)
+	assert.Contains(t, string(payload), "<response><count>2</count><name>kdeps</name></response>")
+}
+
+func TestMarshalNegotiatedPayloadCSV(t *testing.T) {
+	data := []interface{}{
+		map[string]interface{}{"name": "a", "score": 1},
+		map[string]interface{}{"name": "b", "extra": true},
+	}
+	payload, contentType, err := marshalNegotiatedPayload(data, responseFormatCSV)
+	require.NoError(t, err)
+	assert.Equal(t, "text/csv", contentType)
+	assert.Equal(t, "extra,name,score\n,a,1\ntrue,b,\n", string(payload))
+
+	_, _, err = marshalNegotiatedPayload("just a string", responseFormatCSV)
+	require.Error(t, err)
+}
+
+func TestMarshalMsgpackPayload(t *testing.T) {
+	payload, err := marshalMsgpackPayload(map[string]interface{}{"ok": true, "n": float64(7)})
+	require.NoError(t, err)
+	// fixmap(2), "n" -> 7, "ok" -> true (sorted keys).
+	assert.Equal(t, []byte{0x82, 0xa1, 'n', 0x07, 0xa2, 'o', 'k', 0xc3}, payload)
+}
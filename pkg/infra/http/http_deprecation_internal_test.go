@@ -0,0 +1,50 @@
+package http
+
+import (
+	"log/slog"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kdeps/kdeps/v2/pkg/domain"
+	"github.com/kdeps/kdeps/v2/pkg/events"
+)
+
+func TestApplyDeprecationHeaders(t *testing.T) {
+	s := &Server{logger: slog.Default()}
+	route := domain.Route{
+		Path:          "/api/v1/old",
+		Deprecated:    true,
+		Sunset:        "2027-01-01",
+		SuccessorLink: "https://api.example.com/v2/new",
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(stdhttp.MethodGet, "/api/v1/old", nil)
+	s.applyDeprecationHeaders(w, r, route)
+
+	assert.Equal(t, "true", w.Header().Get("Deprecation"))
+	assert.Equal(t, "Fri, 01 Jan 2027 00:00:00 GMT", w.Header().Get("Sunset"))
+	assert.Equal(t, `<https://api.example.com/v2/new>; rel="successor-version"`, w.Header().Get("Link"))
+
+	hits := events.DeprecatedRouteHits()
+	assert.GreaterOrEqual(t, hits["GET /api/v1/old"], int64(1))
+}
+
+func TestApplyDeprecationHeadersNoop(t *testing.T) {
+	s := &Server{logger: slog.Default()}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(stdhttp.MethodGet, "/api/v1/current", nil)
+	s.applyDeprecationHeaders(w, r, domain.Route{Path: "/api/v1/current"})
+
+	assert.Empty(t, w.Header().Get("Deprecation"))
+	assert.Empty(t, w.Header().Get("Sunset"))
+}
+
+func TestFormatSunsetDate(t *testing.T) {
+	assert.Equal(t, "Fri, 01 Jan 2027 00:00:00 GMT", formatSunsetDate("2027-01-01"))
+	assert.Equal(t, "Sat, 15 May 2027 10:30:00 GMT", formatSunsetDate("2027-05-15T10:30:00Z"))
+	assert.Equal(t, "soon", formatSunsetDate("soon"), "unparsable values pass through")
+}
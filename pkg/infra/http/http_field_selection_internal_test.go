@@ -0,0 +1,66 @@
+package http
+
+import (
+	stdhttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kdeps/kdeps/v2/pkg/domain"
+)
+
+func fieldsRequest(t *testing.T, query string) *stdhttp.Request {
+	t.Helper()
+	return httptest.NewRequest(stdhttp.MethodGet, "/api"+query, nil)
+}
+
+func TestApplyFieldSelection(t *testing.T) {
+	data := map[string]interface{}{
+		"name": "Ada",
+		"address": map[string]interface{}{
+			"city": "London",
+			"zip":  "N1",
+		},
+		"secretScore": 97,
+	}
+
+	projected := applyFieldSelection(fieldsRequest(t, "?fields=name,address.city"), nil, data)
+	assert.Equal(t, map[string]interface{}{
+		"name":    "Ada",
+		"address": map[string]interface{}{"city": "London"},
+	}, projected)
+
+	// Selecting a whole subtree keeps it intact.
+	projected = applyFieldSelection(fieldsRequest(t, "?fields=address"), nil, data)
+	assert.Equal(t, map[string]interface{}{
+		"address": map[string]interface{}{"city": "London", "zip": "N1"},
+	}, projected)
+
+	// No fields parameter passes through.
+	assert.Equal(t, data, applyFieldSelection(fieldsRequest(t, ""), nil, data))
+}
+
+func TestApplyFieldSelectionLists(t *testing.T) {
+	data := []interface{}{
+		map[string]interface{}{"id": 1, "payload": "big"},
+		map[string]interface{}{"id": 2, "payload": "bigger"},
+	}
+	projected := applyFieldSelection(fieldsRequest(t, "?fields=id"), nil, data)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"id": 1},
+		map[string]interface{}{"id": 2},
+	}, projected)
+}
+
+func TestApplyFieldSelectionDisabled(t *testing.T) {
+	wf := &domain.Workflow{Settings: domain.WorkflowSettings{
+		APIServer: &domain.APIServerConfig{DisableFieldSelection: true},
+	}}
+	data := map[string]interface{}{"name": "Ada", "extra": true}
+	assert.Equal(t, data, applyFieldSelection(fieldsRequest(t, "?fields=name"), wf, data))
+}
+
+func TestApplyFieldSelectionScalarPassthrough(t *testing.T) {
+	assert.Equal(t, "plain", applyFieldSelection(fieldsRequest(t, "?fields=x"), nil, "plain"))
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 Kdeps, KvK 94834768
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kdeps/kdeps/v2/pkg/domain"
+)
+
+// slowExecutor is a WorkflowExecutor that blocks until released.
+type slowExecutor struct {
+	release chan struct{}
+	result  interface{}
+	err     error
+}
+
+func (e *slowExecutor) Execute(_ *domain.Workflow, _ interface{}) (interface{}, error) {
+	if e.release != nil {
+		<-e.release
+	}
+	return e.result, e.err
+}
+
+func newJobsTestServer(t *testing.T, exec WorkflowExecutor) *Server {
+	t.Helper()
+	server, err := NewServer(&domain.Workflow{}, exec, nil)
+	require.NoError(t, err)
+	return server
+}
+
+func TestJobStore_CreateGetFinish(t *testing.T) {
+	store := newJobStore()
+	job := store.create("")
+	assert.Equal(t, JobStatusPending, job.Status)
+
+	got, ok := store.get(job.ID)
+	require.True(t, ok)
+	assert.Equal(t, job.ID, got.ID)
+
+	store.setRunning(job.ID)
+	got, _ = store.get(job.ID)
+	assert.Equal(t, JobStatusRunning, got.Status)
+
+	finished := store.finish(job.ID, map[string]interface{}{"ok": true}, nil)
+	assert.Equal(t, JobStatusCompleted, finished.Status)
+	require.NotNil(t, finished.CompletedAt)
+
+	failed := store.create("")
+	got = store.finish(failed.ID, nil, errors.New("boom"))
+	assert.Equal(t, JobStatusFailed, got.Status)
+	assert.Equal(t, "boom", got.Error)
+}
+
+func TestJobStore_Prune(t *testing.T) {
+	store := newJobStore()
+	old := store.create("")
+	past := time.Now().UTC().Add(-2 * jobRetention)
+	store.mu.Lock()
+	store.jobs[old.ID].Status = JobStatusCompleted
+	store.jobs[old.ID].CompletedAt = &past
+	store.mu.Unlock()
+
+	store.create("") // triggers prune
+	_, ok := store.get(old.ID)
+	assert.False(t, ok)
+}
+
+func TestHandleJobSubmitAndStatus(t *testing.T) {
+	release := make(chan struct{})
+	exec := &slowExecutor{release: release, result: map[string]interface{}{"answer": 42}}
+	server := newJobsTestServer(t, exec)
+
+	submit := httptest.NewRequest(stdhttp.MethodPost, "/jobs", strings.NewReader(`{"q":"x"}`))
+	submit.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.HandleJobSubmit(rec, submit)
+	require.Equal(t, stdhttp.StatusAccepted, rec.Code)
+
+	var accepted map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &accepted))
+	jobID, _ := accepted["jobId"].(string)
+	require.NotEmpty(t, jobID)
+
+	// Still pending/running while the executor blocks.
+	statusReq := httptest.NewRequest(stdhttp.MethodGet, "/jobs/"+jobID, nil)
+	rec = httptest.NewRecorder()
+	server.HandleJobStatus(rec, statusReq)
+	require.Equal(t, stdhttp.StatusOK, rec.Code)
+	var job Job
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &job))
+	assert.Contains(t, []string{JobStatusPending, JobStatusRunning}, job.Status)
+
+	close(release)
+	require.Eventually(t, func() bool {
+		got, ok := server.ensureJobStore().get(jobID)
+		return ok && got.Status == JobStatusCompleted
+	}, 2*time.Second, 10*time.Millisecond)
+
+	rec = httptest.NewRecorder()
+	server.HandleJobStatus(rec, httptest.NewRequest(stdhttp.MethodGet, "/jobs/"+jobID, nil))
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &job))
+	assert.Equal(t, JobStatusCompleted, job.Status)
+	result, ok := job.Result.(map[string]interface{})
+	require.True(t, ok)
+	assert.InDelta(t, 42, result["answer"].(float64), 1e-9)
+}
+
+func TestHandleJobStatus_NotFound(t *testing.T) {
+	server := newJobsTestServer(t, &slowExecutor{})
+	rec := httptest.NewRecorder()
+	server.HandleJobStatus(rec, httptest.NewRequest(stdhttp.MethodGet, "/jobs/unknown", nil))
+	assert.Equal(t, stdhttp.StatusNotFound, rec.Code)
+}
+
+func TestHandleJobSubmit_CallbackDelivered(t *testing.T) {
+	received := make(chan Job, 1)
+	callbackSrv := httptest.NewServer(stdhttp.HandlerFunc(
+		func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+			var job Job
+			_ = json.NewDecoder(r.Body).Decode(&job)
+			received <- job
+			w.WriteHeader(stdhttp.StatusOK)
+		}))
+	defer callbackSrv.Close()
+
+	server := newJobsTestServer(t, &slowExecutor{result: "done"})
+	submit := httptest.NewRequest(
+		stdhttp.MethodPost, "/jobs?callback="+callbackSrv.URL, strings.NewReader(`{}`),
+	)
+	rec := httptest.NewRecorder()
+	server.HandleJobSubmit(rec, submit)
+	require.Equal(t, stdhttp.StatusAccepted, rec.Code)
+
+	select {
+	case job := <-received:
+		assert.Equal(t, JobStatusCompleted, job.Status)
+	case <-time.After(2 * time.Second):
+		t.Fatal("callback was not delivered")
+	}
+}
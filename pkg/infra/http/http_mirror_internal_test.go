@@ -0,0 +1,116 @@
+package http
+
+import (
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kdeps/kdeps/v2/pkg/domain"
+)
+
+type recordingExecutor struct {
+	executed chan *RequestContext
+	output   interface{}
+}
+
+func (e *recordingExecutor) Execute(_ *domain.Workflow, req interface{}) (interface{}, error) {
+	if reqCtx, ok := req.(*RequestContext); ok {
+		e.executed <- reqCtx
+	}
+	return e.output, nil
+}
+
+func newMirrorTestServer(t *testing.T, cfg *domain.MirrorConfig) (*Server, *recordingExecutor) {
+	t.Helper()
+	executor := &recordingExecutor{executed: make(chan *RequestContext, 1), output: "shadow-out"}
+	s := &Server{
+		Workflow: &domain.Workflow{Settings: domain.WorkflowSettings{
+			APIServer: &domain.APIServerConfig{Mirror: cfg},
+		}},
+		Executor: executor,
+		logger:   slog.Default(),
+		versions: map[string]*domain.Workflow{
+			"v2": {Metadata: domain.WorkflowMetadata{Name: "shadow"}},
+		},
+	}
+	return s, executor
+}
+
+func TestMaybeMirrorRequestRunsShadowAndRecords(t *testing.T) {
+	recordPath := filepath.Join(t.TempDir(), "mirror.jsonl")
+	s, executor := newMirrorTestServer(t, &domain.MirrorConfig{
+		Version: "v2", RecordPath: recordPath,
+	})
+
+	s.maybeMirrorRequest(&RequestContext{
+		Method:    "POST",
+		Path:      "/chat",
+		ID:        "req-1",
+		SessionID: "real-session",
+		Body:      map[string]interface{}{"q": "hi"},
+	})
+
+	select {
+	case shadowCtx := <-executor.executed:
+		assert.Empty(t, shadowCtx.SessionID, "shadow runs detached from the real session")
+		assert.Equal(t, "req-1", shadowCtx.ID)
+	case <-time.After(2 * time.Second):
+		t.Fatal("shadow execution never ran")
+	}
+
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(recordPath)
+		return err == nil
+	}, 2*time.Second, 20*time.Millisecond)
+
+	data, err := os.ReadFile(recordPath)
+	require.NoError(t, err)
+	var record mirrorRecord
+	require.NoError(t, json.Unmarshal(data, &record))
+	assert.Equal(t, "v2", record.Version)
+	assert.Equal(t, "shadow-out", record.Output)
+	assert.Equal(t, "/chat", record.Path)
+}
+
+func TestMaybeMirrorRequestSampling(t *testing.T) {
+	s, executor := newMirrorTestServer(t, &domain.MirrorConfig{
+		Version: "v2", SampleRate: 0.5,
+		RecordPath: filepath.Join(t.TempDir(), "mirror.jsonl"),
+	})
+
+	orig := mirrorSampleFloat
+	t.Cleanup(func() { mirrorSampleFloat = orig })
+
+	mirrorSampleFloat = func() float64 { return 0.9 }
+	s.maybeMirrorRequest(&RequestContext{ID: "skipped"})
+	select {
+	case <-executor.executed:
+		t.Fatal("request above the sample rate must not mirror")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	mirrorSampleFloat = func() float64 { return 0.1 }
+	s.maybeMirrorRequest(&RequestContext{ID: "mirrored"})
+	select {
+	case shadowCtx := <-executor.executed:
+		assert.Equal(t, "mirrored", shadowCtx.ID)
+	case <-time.After(2 * time.Second):
+		t.Fatal("sampled-in request never mirrored")
+	}
+}
+
+func TestMaybeMirrorRequestUnknownVersion(t *testing.T) {
+	s, executor := newMirrorTestServer(t, &domain.MirrorConfig{Version: "ghost"})
+	s.maybeMirrorRequest(&RequestContext{ID: "req"})
+	select {
+	case <-executor.executed:
+		t.Fatal("unknown versions must not execute")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
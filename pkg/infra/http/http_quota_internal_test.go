@@ -0,0 +1,107 @@
+package http
+
+import (
+	stdhttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kdepsconfig "github.com/kdeps/kdeps/v2/pkg/config"
+	"github.com/kdeps/kdeps/v2/pkg/events"
+)
+
+func setupQuotaTest(t *testing.T, quotas map[string]kdepsconfig.QuotaConfig) {
+	t.Helper()
+	events.ResetTenantUsageForTesting()
+	resetTenantCacheForTesting()
+	resetQuotaCacheForTesting()
+	t.Cleanup(func() {
+		events.ResetTenantUsageForTesting()
+		resetTenantCacheForTesting()
+		resetQuotaCacheForTesting()
+	})
+
+	origTenants := loadTenantsFunc
+	origQuotas := loadQuotasFunc
+	t.Cleanup(func() {
+		loadTenantsFunc = origTenants
+		loadQuotasFunc = origQuotas
+	})
+	loadTenantsFunc = func() map[string]string { return map[string]string{"key-acme": "acme"} }
+	loadQuotasFunc = func() map[string]kdepsconfig.QuotaConfig { return quotas }
+}
+
+func quotaTestRequest() *stdhttp.Request {
+	r := httptest.NewRequest(stdhttp.MethodPost, "/api/run", nil)
+	r.Header.Set("X-API-Key", "key-acme")
+	return r
+}
+
+func TestQuotaMiddlewareAdmitsAndCounts(t *testing.T) {
+	setupQuotaTest(t, map[string]kdepsconfig.QuotaConfig{"acme": {DailyRequests: 2}})
+	s := &Server{}
+	handled := 0
+	handler := s.QuotaMiddleware(func(stdhttp.ResponseWriter, *stdhttp.Request) { handled++ })
+
+	w := httptest.NewRecorder()
+	handler(w, quotaTestRequest())
+	assert.Equal(t, 1, handled)
+	assert.Equal(t, "2", w.Header().Get("X-Quota-Limit"))
+	assert.Equal(t, "1", w.Header().Get("X-Quota-Remaining"))
+
+	handler(httptest.NewRecorder(), quotaTestRequest())
+	assert.Equal(t, 2, handled)
+
+	// Third request hits the daily cap.
+	w = httptest.NewRecorder()
+	handler(w, quotaTestRequest())
+	assert.Equal(t, 2, handled, "over-cap requests never reach the handler")
+	assert.Equal(t, stdhttp.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "0", w.Header().Get("X-Quota-Remaining"))
+	assert.NotEmpty(t, w.Header().Get("X-Quota-Reset"))
+}
+
+func TestQuotaMiddlewareCostCap(t *testing.T) {
+	setupQuotaTest(t, map[string]kdepsconfig.QuotaConfig{"acme": {DailyCost: 1.0}})
+	events.RecordTenantCost("acme", 1.5)
+
+	s := &Server{}
+	handler := s.QuotaMiddleware(func(stdhttp.ResponseWriter, *stdhttp.Request) {
+		t.Fatal("over-cost request must not execute")
+	})
+	w := httptest.NewRecorder()
+	handler(w, quotaTestRequest())
+	assert.Equal(t, stdhttp.StatusTooManyRequests, w.Code)
+	assert.Contains(t, w.Body.String(), "cost cap")
+}
+
+func TestQuotaMiddlewareUntenantedAndUncapped(t *testing.T) {
+	setupQuotaTest(t, map[string]kdepsconfig.QuotaConfig{"other": {DailyRequests: 1}})
+
+	s := &Server{}
+	handled := 0
+	handler := s.QuotaMiddleware(func(stdhttp.ResponseWriter, *stdhttp.Request) { handled++ })
+
+	// No API key: no tenant, no quota.
+	handler(httptest.NewRecorder(), httptest.NewRequest(stdhttp.MethodGet, "/api", nil))
+	require.Equal(t, 1, handled)
+
+	// Tenant without a quota entry (and no default) passes.
+	handler(httptest.NewRecorder(), quotaTestRequest())
+	assert.Equal(t, 2, handled)
+}
+
+func TestQuotaDefaultEntry(t *testing.T) {
+	setupQuotaTest(t, map[string]kdepsconfig.QuotaConfig{"default": {DailyRequests: 1}})
+
+	s := &Server{}
+	handled := 0
+	handler := s.QuotaMiddleware(func(stdhttp.ResponseWriter, *stdhttp.Request) { handled++ })
+	handler(httptest.NewRecorder(), quotaTestRequest())
+	w := httptest.NewRecorder()
+	handler(w, quotaTestRequest())
+	assert.Equal(t, 1, handled)
+	assert.Equal(t, stdhttp.StatusTooManyRequests, w.Code)
+}
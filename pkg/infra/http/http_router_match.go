@@ -24,7 +24,39 @@ import (
 )
 
 func isParamPattern(part string) bool {
-	return strings.HasPrefix(part, ":")
+	return strings.HasPrefix(part, ":") ||
+		(strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}"))
+}
+
+// paramName extracts the parameter name from a :id or {id} pattern part.
+func paramName(part string) string {
+	if strings.HasPrefix(part, ":") {
+		return part[1:]
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(part, "{"), "}")
+}
+
+// ExtractPathParams returns the named parameters a pattern captures from
+// path (e.g. /users/{id} against /users/42 yields {"id": "42"}). A
+// non-matching path yields an empty map. Splitting mirrors
+// matchRouterPattern so captures line up with route matching.
+func ExtractPathParams(pattern, path string) map[string]string {
+	params := map[string]string{}
+	patternParts := strings.Split(pattern, "/")
+	pathParts := strings.Split(path, "/")
+	patternParts, _ = stripTrailingWildcard(patternParts)
+	if len(pathParts) < len(patternParts) {
+		return params
+	}
+	for i, part := range patternParts {
+		if !patternPartMatches(part, pathParts[i]) {
+			return map[string]string{}
+		}
+		if isParamPattern(part) {
+			params[paramName(part)] = pathParts[i]
+		}
+	}
+	return params
 }
 
 func isWildcardPattern(part string) bool {
@@ -84,3 +84,27 @@ func TestPathRegisteredInRoutes_PatternMatch(t *testing.T) {
 	assert.True(t, pathRegisteredInRoutes(routes, "/api/456", matchRouterPattern))
 	assert.False(t, pathRegisteredInRoutes(routes, "/other", matchRouterPattern))
 }
+
+func TestExtractPathParams(t *testing.T) {
+	params := ExtractPathParams("/users/{id}/orders/{orderId}", "/users/42/orders/a7")
+	assert.Equal(t, map[string]string{"id": "42", "orderId": "a7"}, params)
+
+	// Colon syntax captures too.
+	params = ExtractPathParams("/users/:id", "/users/42")
+	assert.Equal(t, map[string]string{"id": "42"}, params)
+
+	// Non-matching paths yield no captures.
+	assert.Empty(t, ExtractPathParams("/users/{id}", "/orders/42"))
+
+	// Static patterns capture nothing.
+	assert.Empty(t, ExtractPathParams("/health", "/health"))
+
+	// Trailing wildcards capture the named parts only.
+	params = ExtractPathParams("/files/{bucket}/*", "/files/media/a/b.png")
+	assert.Equal(t, map[string]string{"bucket": "media"}, params)
+}
+
+func TestMatchPatternBraceSyntax(t *testing.T) {
+	assert.True(t, matchRouterPattern("/users/{id}", "/users/42"))
+	assert.False(t, matchRouterPattern("/users/{id}", "/users"))
+}
@@ -30,7 +30,7 @@ func (s *Server) registerWorkflowAPIRoutes() {
 	}
 	for _, route := range s.Workflow.Settings.APIServer.Routes {
 		for _, method := range route.Methods {
-			s.registerAPIServerRoute(route.Path, method)
+			s.registerAPIServerRoute(route, method)
 		}
 	}
 }
@@ -41,20 +41,30 @@ func applyInboundSessionID(r *stdhttp.Request, reqCtx *RequestContext) {
 	}
 }
 
-func (s *Server) registerAPIServerRoute(path, method string) {
-	registerRouterMethod(s.Router, method, path, s.HandleRequest)
+func (s *Server) registerAPIServerRoute(route domain.Route, method string) {
+	target := route.TargetActionID
+	pattern := route.Path
+	registerRouterMethod(s.Router, method, pattern, func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		s.applyDeprecationHeaders(w, r, route)
+		s.handleRequestWithRoute(w, r, target, pattern)
+	})
 }
 
-func (s *Server) executeAndRespond(
+// executeWorkflowAndRespond runs the request against an explicit workflow,
+// used by version-pinned routes as well as the default path.
+func (s *Server) executeWorkflowAndRespond(
 	w stdhttp.ResponseWriter,
 	r *stdhttp.Request,
+	workflow *domain.Workflow,
 	reqCtx *RequestContext,
 	uploadedFiles []*domain.UploadedFile,
 ) {
-	result, err := s.Executor.Execute(s.Workflow, reqCtx)
+	result, err := s.Executor.Execute(workflow, reqCtx)
 	r = s.applySessionFromRequestContext(r, reqCtx)
 	defer s.cleanupUploadedFiles(uploadedFiles)
 
+	s.maybeCaptureRequest(reqCtx, result, err)
+
 	if err != nil {
 		s.respondWorkflowError(w, r, err)
 		return
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kdeps/kdeps/v2/pkg/domain"
+)
+
+func TestSystemdActivationListenerAbsent(t *testing.T) {
+	t.Setenv("LISTEN_PID", "")
+	t.Setenv("LISTEN_FDS", "")
+	listener, err := systemdActivationListener()
+	require.NoError(t, err)
+	assert.Nil(t, listener)
+
+	// A foreign PID means the sockets are not for us.
+	t.Setenv("LISTEN_PID", strconv.Itoa(os.Getpid()+1))
+	t.Setenv("LISTEN_FDS", "1")
+	listener, err = systemdActivationListener()
+	require.NoError(t, err)
+	assert.Nil(t, listener)
+}
+
+func TestUnixSocketListener(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kdeps.sock")
+	listener, err := unixSocketListener(path, 0o600)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	conn.Close()
+}
+
+func TestUnixSocketListenerReplacesStaleSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stale.sock")
+	first, err := unixSocketListener(path, 0o660)
+	require.NoError(t, err)
+	first.Close()
+
+	second, err := unixSocketListener(path, 0o660)
+	require.NoError(t, err)
+	second.Close()
+}
+
+func TestConfiguredUnixSocketMode(t *testing.T) {
+	assert.Equal(t, os.FileMode(defaultUnixSocketMode), configuredUnixSocketMode(nil))
+
+	wf := &domain.Workflow{Settings: domain.WorkflowSettings{
+		APIServer: &domain.APIServerConfig{UnixSocketMode: "0666"},
+	}}
+	assert.Equal(t, os.FileMode(0o666), configuredUnixSocketMode(wf))
+
+	wf.Settings.APIServer.UnixSocketMode = "not-octal"
+	assert.Equal(t, os.FileMode(defaultUnixSocketMode), configuredUnixSocketMode(wf))
+}
@@ -33,12 +33,19 @@ func (s *Server) logStartingHTTP(addr string) {
 }
 
 func (s *Server) listenAndServe(addr, certFile, keyFile string) error {
-	if hasTLSCertificates(certFile, keyFile) {
-		s.logStartingHTTPS(addr, certFile)
-		return s.httpServer.ListenAndServeTLS(certFile, keyFile)
+	if listener, desc, listenErr := s.resolveCustomListener(); listenErr != nil {
+		return listenErr
+	} else if listener != nil {
+		s.logger.Info("starting HTTP server", "listener", desc)
+		return s.httpServer.Serve(listener)
 	}
-	s.logStartingHTTP(addr)
-	return s.httpServer.ListenAndServe()
+
+	settings := resolveTLSSettings(s.Workflow)
+	if settings == nil && hasTLSCertificates(certFile, keyFile) {
+		// Callers without a workflow still pass explicit cert/key paths.
+		settings = &domain.TLSSettings{CertFile: certFile, KeyFile: keyFile}
+	}
+	return s.serveWithTLSSettings(addr, settings)
 }
 
 func (s *Server) enableHotReloadIfDev(devMode bool) {
@@ -58,6 +65,10 @@ func (s *Server) setupCoreMiddleware() {
 	s.Router.Use(RequestIDMiddleware())
 	s.Router.Use(DebugModeMiddleware())
 	s.Router.Use(SessionMiddleware())
+	s.Router.Use(SizeMetricsMiddleware)
+	s.Router.Use(s.CompressionMiddleware)
+	s.Router.Use(s.QuotaMiddleware)
+	s.Router.Use(AlertingMiddleware)
 }
 
 func workflowTLSCertificates(workflow *domain.Workflow) (string, string) {
@@ -0,0 +1,40 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kdeps/kdeps/v2/pkg/domain"
+)
+
+func TestResolveTLSSettings(t *testing.T) {
+	assert.Nil(t, resolveTLSSettings(nil))
+	assert.Nil(t, resolveTLSSettings(&domain.Workflow{}))
+
+	// Legacy top-level cert/key fields still terminate TLS.
+	legacy := &domain.Workflow{Settings: domain.WorkflowSettings{
+		CertFile: "/etc/certs/tls.crt",
+		KeyFile:  "/etc/certs/tls.key",
+	}}
+	settings := resolveTLSSettings(legacy)
+	require.NotNil(t, settings)
+	assert.Equal(t, "/etc/certs/tls.crt", settings.CertFile)
+
+	// The tls: block wins; empty fields fall back to the legacy ones.
+	mixed := &domain.Workflow{Settings: domain.WorkflowSettings{
+		CertFile: "/legacy.crt",
+		KeyFile:  "/legacy.key",
+		TLS:      &domain.TLSSettings{CertFile: "/block.crt"},
+	}}
+	settings = resolveTLSSettings(mixed)
+	require.NotNil(t, settings)
+	assert.Equal(t, "/block.crt", settings.CertFile)
+	assert.Equal(t, "/legacy.key", settings.KeyFile)
+}
+
+func TestAcmeCacheDir(t *testing.T) {
+	assert.Equal(t, "/var/cache/acme", acmeCacheDir(&domain.ACMEConfig{CacheDir: "/var/cache/acme"}))
+	assert.Contains(t, acmeCacheDir(&domain.ACMEConfig{}), "autocert")
+}
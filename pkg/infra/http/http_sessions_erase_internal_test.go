@@ -0,0 +1,67 @@
+package http
+
+import (
+	"encoding/json"
+	"log/slog"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kdeps/kdeps/v2/pkg/infra/storage"
+)
+
+func TestHandleSessionErase(t *testing.T) {
+	origSession := eraseSessionStoreFunc
+	origMemory := eraseMemoryPrefixFunc
+	origAudit := appendErasureAuditFunc
+	t.Cleanup(func() {
+		eraseSessionStoreFunc = origSession
+		eraseMemoryPrefixFunc = origMemory
+		appendErasureAuditFunc = origAudit
+	})
+
+	var erasedSession, erasedPrefix string
+	eraseSessionStoreFunc = func(sessionID string) (int64, error) {
+		erasedSession = sessionID
+		return 4, nil
+	}
+	eraseMemoryPrefixFunc = func(prefix string) (int64, error) {
+		erasedPrefix = prefix
+		return 2, nil
+	}
+	auditPath := filepath.Join(t.TempDir(), "erasures.jsonl")
+	appendErasureAuditFunc = func(_ string, record storage.ErasureRecord) error {
+		return storage.AppendErasureAudit(auditPath, record)
+	}
+
+	s := &Server{logger: slog.Default()}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(stdhttp.MethodDelete, "/sessions/sess-42", nil)
+	s.HandleSessionErase(w, r)
+
+	require.Equal(t, stdhttp.StatusOK, w.Code)
+	assert.Equal(t, "sess-42", erasedSession)
+	assert.Equal(t, "sess-42:", erasedPrefix)
+	assert.Contains(t, w.Body.String(), `"sessionKeys": 4`)
+
+	data, err := os.ReadFile(auditPath)
+	require.NoError(t, err)
+	var record storage.ErasureRecord
+	require.NoError(t, json.Unmarshal(data, &record))
+	assert.Equal(t, "sess-42", record.SessionID)
+	assert.Equal(t, int64(4), record.SessionKeys)
+	assert.Equal(t, int64(2), record.MemoryKeys)
+}
+
+func TestHandleSessionEraseRequiresID(t *testing.T) {
+	s := &Server{logger: slog.Default()}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(stdhttp.MethodDelete, "/sessions/", nil)
+	s.HandleSessionErase(w, r)
+	assert.Equal(t, stdhttp.StatusBadRequest, w.Code)
+}
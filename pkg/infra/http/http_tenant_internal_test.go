@@ -0,0 +1,65 @@
+// Copyright 2026 Kdeps, KvK 94834768
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+package http
+
+import (
+	stdhttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func withTenantMap(t *testing.T, tenants map[string]string) {
+	t.Helper()
+	orig := loadTenantsFunc
+	loadTenantsFunc = func() map[string]string { return tenants }
+	resetTenantCacheForTesting()
+	t.Cleanup(func() {
+		loadTenantsFunc = orig
+		resetTenantCacheForTesting()
+	})
+}
+
+func TestResolveTenant_BearerToken(t *testing.T) {
+	withTenantMap(t, map[string]string{"key-acme": "acme", "key-globex": "globex"})
+
+	r := httptest.NewRequest(stdhttp.MethodPost, "/", nil)
+	r.Header.Set("Authorization", "Bearer key-acme")
+	assert.Equal(t, "acme", resolveTenant(r))
+
+	r.Header.Set("Authorization", "Bearer unknown-key")
+	assert.Empty(t, resolveTenant(r))
+}
+
+func TestResolveTenant_APIKeyHeader(t *testing.T) {
+	withTenantMap(t, map[string]string{"key-globex": "globex"})
+
+	r := httptest.NewRequest(stdhttp.MethodPost, "/", nil)
+	r.Header.Set("X-API-Key", "key-globex")
+	assert.Equal(t, "globex", resolveTenant(r))
+}
+
+func TestResolveTenant_NoTenantsConfigured(t *testing.T) {
+	withTenantMap(t, nil)
+
+	r := httptest.NewRequest(stdhttp.MethodPost, "/", nil)
+	r.Header.Set("Authorization", "Bearer anything")
+	assert.Empty(t, resolveTenant(r))
+}
+
+func TestParseRequest_AttachesTenant(t *testing.T) {
+	withTenantMap(t, map[string]string{"key-acme": "acme"})
+
+	server := newJobsTestServer(t, &slowExecutor{})
+	r := httptest.NewRequest(stdhttp.MethodPost, "/api/chat", nil)
+	r.Header.Set("Authorization", "Bearer key-acme")
+	reqCtx := server.ParseRequest(r, nil)
+	assert.Equal(t, "acme", reqCtx.Tenant)
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 Kdeps, KvK 94834768
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+package http
+
+import (
+	stdhttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kdeps/kdeps/v2/pkg/domain"
+)
+
+// versionRecordingExecutor records which workflow served each request.
+type versionRecordingExecutor struct {
+	served []string
+}
+
+func (e *versionRecordingExecutor) Execute(
+	workflow *domain.Workflow,
+	_ interface{},
+) (interface{}, error) {
+	e.served = append(e.served, workflow.Metadata.Version)
+	return map[string]interface{}{"version": workflow.Metadata.Version}, nil
+}
+
+func versionedWorkflow(version string) *domain.Workflow {
+	return &domain.Workflow{
+		Metadata: domain.WorkflowMetadata{Name: "agent", Version: version},
+		Settings: domain.WorkflowSettings{
+			APIServer: &domain.APIServerConfig{
+				Routes: []domain.Route{
+					{Path: "/api/chat", Methods: []string{stdhttp.MethodPost}},
+				},
+			},
+		},
+	}
+}
+
+func TestAddWorkflowVersion_PathPrefixRouting(t *testing.T) {
+	exec := &versionRecordingExecutor{}
+	server, err := NewServer(versionedWorkflow("v1.0.0"), exec, nil)
+	require.NoError(t, err)
+	server.SetupRoutes()
+	server.AddWorkflowVersion("v1.2.0", versionedWorkflow("v1.2.0"))
+
+	req := httptest.NewRequest(stdhttp.MethodPost, "/v1.2.0/api/chat", nil)
+	rec := httptest.NewRecorder()
+	server.Router.ServeHTTP(rec, req)
+
+	require.Equal(t, []string{"v1.2.0"}, exec.served)
+	assert.Equal(t, stdhttp.StatusOK, rec.Code)
+}
+
+func TestRequestWorkflow_HeaderPinning(t *testing.T) {
+	exec := &versionRecordingExecutor{}
+	server, err := NewServer(versionedWorkflow("v1.0.0"), exec, nil)
+	require.NoError(t, err)
+	server.AddWorkflowVersion("v2.0.0", versionedWorkflow("v2.0.0"))
+
+	pinned := httptest.NewRequest(stdhttp.MethodPost, "/api/chat", nil)
+	pinned.Header.Set(versionHeader, "v2.0.0")
+	assert.Equal(t, "v2.0.0", server.requestWorkflow(pinned).Metadata.Version)
+
+	unknown := httptest.NewRequest(stdhttp.MethodPost, "/api/chat", nil)
+	unknown.Header.Set(versionHeader, "v9.9.9")
+	assert.Equal(t, "v1.0.0", server.requestWorkflow(unknown).Metadata.Version)
+
+	plain := httptest.NewRequest(stdhttp.MethodPost, "/api/chat", nil)
+	assert.Equal(t, "v1.0.0", server.requestWorkflow(plain).Metadata.Version)
+}
+
+func TestWorkflowVersions(t *testing.T) {
+	server, err := NewServer(versionedWorkflow("v1.0.0"), &versionRecordingExecutor{}, nil)
+	require.NoError(t, err)
+	assert.Empty(t, server.WorkflowVersions())
+	server.AddWorkflowVersion("v1.1.0", versionedWorkflow("v1.1.0"))
+	assert.Equal(t, []string{"v1.1.0"}, server.WorkflowVersions())
+}
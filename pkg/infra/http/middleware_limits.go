@@ -54,7 +54,14 @@ func RateLimitMiddleware(
 	store := newIPLimiterStore(requestsPerMinute, burst)
 	return func(next stdhttp.HandlerFunc) stdhttp.HandlerFunc {
 		return func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
-			if !store.get(extractClientIP(r, trustedProxies)).Allow() {
+			// Tenanted requests rate-limit per tenant, not per IP, so one
+			// customer cannot consume another's budget (or evade its own
+			// by rotating IPs).
+			key := extractClientIP(r, trustedProxies)
+			if tenant := resolveTenant(r); tenant != "" {
+				key = "tenant:" + tenant
+			}
+			if !store.get(key).Allow() {
 				respondRateLimitExceeded(w, r)
 				return
 			}
@@ -82,6 +89,13 @@ func BodyLimitMiddleware(maxBytes int64) func(stdhttp.HandlerFunc) stdhttp.Handl
 	}
 }
 
+// queueSpillover, when set (EnableRunQueue), persists a request rejected by
+// the concurrency limiter and returns its queue ID; the client gets 202
+// instead of 503.
+//
+//nolint:gochecknoglobals // installed once at server startup
+var queueSpillover func(r *stdhttp.Request) (string, bool)
+
 func ConcurrentLimitMiddleware(limit int) func(stdhttp.HandlerFunc) stdhttp.HandlerFunc {
 	debugEnter("ConcurrentLimitMiddleware")
 	sem := make(chan struct{}, limit)
@@ -92,6 +106,15 @@ func ConcurrentLimitMiddleware(limit int) func(stdhttp.HandlerFunc) stdhttp.Hand
 				defer func() { <-sem }()
 				next(w, r)
 			default:
+				if queueSpillover != nil {
+					if id, ok := queueSpillover(r); ok {
+						writeJSONResponse(w, stdhttp.StatusAccepted, map[string]interface{}{
+							"queued":  true,
+							"queueId": id,
+						})
+						return
+					}
+				}
 				respondMiddlewareError(
 					w,
 					r,
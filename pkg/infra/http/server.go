@@ -26,7 +26,9 @@ import (
 	"time"
 
 	"github.com/kdeps/kdeps/v2/pkg/domain"
+	"github.com/kdeps/kdeps/v2/pkg/events"
 	"github.com/kdeps/kdeps/v2/pkg/infra/fs"
+	"github.com/kdeps/kdeps/v2/pkg/infra/queue"
 	"github.com/kdeps/kdeps/v2/pkg/parser/yaml"
 )
 
@@ -65,6 +67,15 @@ type RequestContext struct {
 	IP        string // Client IP address
 	ID        string // Request ID
 	SessionID string // Session ID from cookie (if available)
+	Tenant    string // Tenant resolved from the API key (empty = untenanted)
+
+	// TargetActionID overrides the workflow's targetActionId for this
+	// request (per-route entrypoints).
+	TargetActionID string
+
+	// Params are path parameters captured by the matched route pattern
+	// (e.g. /users/{id}).
+	Params map[string]string
 }
 
 // FileUpload matches executor.FileUpload.
@@ -93,6 +104,22 @@ type Server struct {
 
 	// HTTP server for graceful shutdown
 	httpServer *stdhttp.Server
+
+	// Background jobs accepted via POST /jobs (lazily initialized)
+	jobs *jobStore
+
+	// Per-run progress, fed by the engine's event stream (optional)
+	progress *events.ProgressTracker
+
+	// Additional workflow versions served under /<version>/ path prefixes
+	// and via the X-Workflow-Version header
+	versions map[string]*domain.Workflow
+
+	// Durable run queue for saturated requests (optional)
+	runQueue *queue.Queue
+
+	// mirror records shadow-traffic runs (apiServer.mirror).
+	mirror *mirrorLog
 }
 
 // FileWatcher watches for file changes.
@@ -50,6 +50,7 @@ func (s *Server) ParseRequest(
 		IP:        clientIP,
 		ID:        requestID,
 		SessionID: "",
+		Tenant:    resolveTenant(r),
 	}
 }
 
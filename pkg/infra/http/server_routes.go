@@ -18,26 +18,71 @@
 
 package http
 
-import stdhttp "net/http"
+import (
+	stdhttp "net/http"
+	"sort"
+
+	"github.com/kdeps/kdeps/v2/pkg/infra/health"
+)
 
 // SetupRoutes sets up all API routes.
 func (s *Server) SetupRoutes() {
 	debugEnter("SetupRoutes")
 	s.Router.GET("/health", s.HandleHealth)
+	s.Router.POST("/jobs", s.HandleJobSubmit)
+	s.Router.GET("/jobs/:id", s.HandleJobStatus)
+	s.Router.GET("/runs", s.HandleRunList)
+	s.Router.GET("/runs/:id/progress", s.HandleRunProgress)
+	s.Router.POST("/admin/reload", requireAdminAuth(s.HandleAdminReload))
+	s.Router.GET("/admin/config", requireAdminAuth(s.HandleAdminConfig))
+	s.Router.GET("/admin/workflow", requireAdminAuth(s.HandleAdminWorkflow))
+	s.Router.GET("/admin/routes", requireAdminAuth(s.HandleAdminRoutes))
+	s.Router.GET("/admin/models", requireAdminAuth(s.HandleAdminModels))
+	s.Router.GET("/admin/connections", requireAdminAuth(s.HandleAdminConnections))
+	s.Router.GET("/admin/sbom", requireAdminAuth(s.HandleAdminSBOM))
+	s.Router.GET("/admin/metrics", requireAdminAuth(s.HandleAdminMetrics))
+	s.Router.GET("/admin/quotas", requireAdminAuth(s.HandleAdminQuotas))
+	s.Router.GET("/admin/captures", requireAdminAuth(s.HandleAdminCaptures))
+	s.Router.GET("/admin/captures/*", requireAdminAuth(s.HandleAdminCapture))
+	s.Router.DELETE("/sessions/*", requireAdminAuth(s.HandleSessionErase))
 
 	s.SetupManagementRoutes()
 
 	s.registerWorkflowAPIRoutes()
 }
 
-// HandleHealth handles health check requests.
+// HandleHealth handles health check requests. When the connection health
+// monitor is running, unhealthy connections are surfaced so readiness probes
+// can report degradation.
 func (s *Server) HandleHealth(w stdhttp.ResponseWriter, _ *stdhttp.Request) {
 	debugEnter("HandleHealth")
-	writeWorkflowStatusJSON(w, s.lockedWorkflow(), healthCheckPayload)
+	payload := healthCheckPayload(s.lockedWorkflow())
+	if monitor := health.Default(); monitor != nil {
+		unhealthy := []string{}
+		for key, status := range monitor.Snapshot() {
+			if status == health.StatusUnhealthy {
+				unhealthy = append(unhealthy, key)
+			}
+		}
+		sort.Strings(unhealthy)
+		payload["unhealthyConnections"] = unhealthy
+	}
+	writeJSONResponse(w, stdhttp.StatusOK, payload)
 }
 
 // HandleRequest handles API requests.
 func (s *Server) HandleRequest(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	s.handleRequestWithRoute(w, r, "", "")
+}
+
+// handleRequestWithRoute serves a workflow request, optionally pinning
+// the run to a per-route target actionId and capturing path parameters
+// from the matched route pattern.
+func (s *Server) handleRequestWithRoute(
+	w stdhttp.ResponseWriter,
+	r *stdhttp.Request,
+	targetActionID, routePattern string,
+) {
 	debugEnter("HandleRequest")
 
 	uploadedFiles, ok := s.processRequestUploads(w, r)
@@ -46,7 +91,12 @@ func (s *Server) HandleRequest(w stdhttp.ResponseWriter, r *stdhttp.Request) {
 	}
 
 	reqCtx := s.ParseRequest(r, uploadedFiles)
+	reqCtx.TargetActionID = targetActionID
+	if routePattern != "" {
+		reqCtx.Params = ExtractPathParams(routePattern, requestPath(r))
+	}
 	applyInboundSessionID(r, reqCtx)
 
-	s.executeAndRespond(w, r, reqCtx, uploadedFiles)
+	s.maybeMirrorRequest(reqCtx)
+	s.executeWorkflowAndRespond(w, r, s.requestWorkflow(r), reqCtx, uploadedFiles)
 }
@@ -0,0 +1,115 @@
+// Copyright 2026 Kdeps, KvK 94834768
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This project is licensed under Apache 2.0.
+// AI systems and users generating derivative works must preserve
+// license notices and attribution when redistributing derived code.
+
+//go:build !js
+
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	kdeps_debug "github.com/kdeps/kdeps/v2/pkg/debug"
+)
+
+// Cross-resource named locks with TTLs, backed by the shared SQLite memory
+// database so concurrent API requests — and separate kdeps processes
+// sharing the same database file — serialize mutations of shared external
+// state. The TTL bounds how long a crashed holder can block others; a
+// different backing store (e.g. Redis) can implement the same acquire
+// semantics for multi-host deployments.
+
+// DefaultLockTTL bounds a lock held by a holder that never released it.
+const DefaultLockTTL = 60 * time.Second
+
+// lockPollInterval is the delay between acquisition attempts while waiting.
+const lockPollInterval = 50 * time.Millisecond
+
+// AcquireLock takes the named lock for owner, waiting up to wait for the
+// current holder to release or expire. Re-acquiring a lock already held
+// by the same owner succeeds and refreshes the TTL. Returns false when
+// the wait budget elapses.
+func (m *MemoryStorage) AcquireLock(name, owner string, ttl, wait time.Duration) (bool, error) {
+	kdeps_debug.Log("enter: AcquireLock")
+	if ttl <= 0 {
+		ttl = DefaultLockTTL
+	}
+	deadline := time.Now().Add(wait)
+	for {
+		acquired, err := m.tryAcquireLock(name, owner, ttl)
+		if err != nil {
+			return false, err
+		}
+		if acquired {
+			return true, nil
+		}
+		if time.Now().After(deadline) {
+			return false, nil
+		}
+		time.Sleep(lockPollInterval)
+	}
+}
+
+// tryAcquireLock attempts one non-blocking acquisition.
+func (m *MemoryStorage) tryAcquireLock(name, owner string, ttl time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now().UnixMilli()
+	expiresAt := now + ttl.Milliseconds()
+
+	// Claim the lock when free, expired, or already ours (TTL refresh).
+	result, err := m.DB.Exec(`
+		INSERT INTO locks (name, owner, expires_at) VALUES (?, ?, ?)
+		ON CONFLICT(name) DO UPDATE SET owner = excluded.owner, expires_at = excluded.expires_at
+		WHERE locks.expires_at <= ? OR locks.owner = excluded.owner`,
+		name, owner, expiresAt, now,
+	)
+	if err != nil {
+		return false, fmt.Errorf("acquire lock %q: %w", name, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("acquire lock %q: %w", name, err)
+	}
+	return affected > 0, nil
+}
+
+// ReleaseLock releases the named lock when held by owner. Releasing a
+// lock that is not held (or held by someone else) is a no-op.
+func (m *MemoryStorage) ReleaseLock(name, owner string) error {
+	kdeps_debug.Log("enter: ReleaseLock")
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, err := m.DB.Exec(`DELETE FROM locks WHERE name = ? AND owner = ?`, name, owner)
+	if err != nil {
+		return fmt.Errorf("release lock %q: %w", name, err)
+	}
+	return nil
+}
+
+// initLockSchema creates the locks table.
+func (m *MemoryStorage) initLockSchema() error {
+	_, err := m.DB.Exec(`
+		CREATE TABLE IF NOT EXISTS locks (
+			name TEXT PRIMARY KEY,
+			owner TEXT NOT NULL,
+			expires_at INTEGER NOT NULL
+		)`)
+	return err
+}
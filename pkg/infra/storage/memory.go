@@ -101,6 +101,9 @@ func NewMemoryStorage(dbPath string) (*MemoryStorage, error) {
 	if initErr := storage.initSchema(); initErr != nil {
 		return nil, fmt.Errorf("failed to initialize schema: %w", initErr)
 	}
+	if lockErr := storage.initLockSchema(); lockErr != nil {
+		return nil, fmt.Errorf("failed to initialize lock schema: %w", lockErr)
+	}
 
 	return storage, nil
 }
@@ -138,7 +141,12 @@ func (m *MemoryStorage) Get(key string) (interface{}, bool) {
 		return nil, false
 	}
 
-	return decodeMemoryValue(valueStr), true
+	plainValue, decErr := decryptStoredValue(valueStr)
+	if decErr != nil {
+		return nil, false
+	}
+
+	return decodeMemoryValue(plainValue), true
 }
 
 // Set stores a value in memory.
@@ -151,6 +159,10 @@ func (m *MemoryStorage) Set(key string, value interface{}) error {
 	if err != nil {
 		return fmt.Errorf("failed to marshal value: %w", err)
 	}
+	storedValue, err := encryptStoredValue(string(valueBytes))
+	if err != nil {
+		return err
+	}
 
 	query := `
 	INSERT INTO memory (key, value, updated_at)
@@ -159,7 +171,7 @@ func (m *MemoryStorage) Set(key string, value interface{}) error {
 		value = excluded.value,
 		updated_at = CURRENT_TIMESTAMP
 	`
-	_, err = m.DB.ExecContext(context.Background(), query, key, string(valueBytes))
+	_, err = m.DB.ExecContext(context.Background(), query, key, storedValue)
 	return err
 }
 
@@ -68,7 +68,11 @@ func (s *SessionStorage) Get(key string) (interface{}, bool) {
 		_ = s.Touch(key) // Touch synchronously to extend TTL
 	}
 
-	return decodeStoredValue(valueStr), true
+	plainValue, decErr := decryptStoredValue(valueStr)
+	if decErr != nil {
+		return nil, false
+	}
+	return decodeStoredValue(plainValue), true
 }
 
 // Set stores a value in session storage.
@@ -88,6 +92,10 @@ func (s *SessionStorage) SetWithTTL(key string, value interface{}, ttl time.Dura
 	if err != nil {
 		return fmt.Errorf("failed to marshal value: %w", err)
 	}
+	storedValue, err := encryptStoredValue(string(valueBytes))
+	if err != nil {
+		return err
+	}
 
 	now := time.Now().UnixMilli()
 	expiresAt := sessionExpiresAt(ttl)
@@ -106,7 +114,7 @@ func (s *SessionStorage) SetWithTTL(key string, value interface{}, ttl time.Dura
 		query,
 		s.SessionID,
 		key,
-		string(valueBytes),
+		storedValue,
 		now,
 		now,
 		expiresAt,
@@ -227,7 +235,11 @@ func (s *SessionStorage) GetAll() (map[string]interface{}, error) {
 			return nil, fmt.Errorf("failed to scan row: %w", scanErr)
 		}
 
-		result[key] = decodeStoredValue(valueStr)
+		plainValue, decErr := decryptStoredValue(valueStr)
+		if decErr != nil {
+			continue // skip rows we cannot decrypt rather than failing the listing
+		}
+		result[key] = decodeStoredValue(plainValue)
 	}
 
 	if rowsErr := rows.Err(); rowsErr != nil {
@@ -21,10 +21,10 @@ var logger *slog.Logger
 func Init(debug, verbose bool) {
 	level := resolveLogLevel(debug, verbose)
 	if os.Getenv("KDEPS_LOG_FORMAT") == "json" {
-		logger = newJSONLogger(level)
+		logger = slog.New(newMaskingHandler(newJSONLogger(level).Handler()))
 		return
 	}
-	logger = newPrettyPackageLogger(level, debug)
+	logger = slog.New(newMaskingHandler(newPrettyPackageLogger(level, debug).Handler()))
 }
 
 func resolveLogLevel(debug, verbose bool) slog.Level {
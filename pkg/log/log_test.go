@@ -63,3 +63,13 @@ func TestError(_ *testing.T) {
 	Init(false, false)
 	Error("test error", "key", "val")
 }
+
+func TestWarnCount(t *testing.T) {
+	Init(false, false)
+	ResetWarnCountForTesting()
+	Warn("first")
+	Warn("second")
+	assert.Equal(t, int64(2), WarnCount())
+	ResetWarnCountForTesting()
+	assert.Equal(t, int64(0), WarnCount())
+}
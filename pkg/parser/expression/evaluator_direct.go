@@ -19,6 +19,7 @@
 package expression
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -36,17 +37,27 @@ func (e *Evaluator) evaluateDirect(
 	env map[string]interface{},
 ) (interface{}, error) {
 	kdeps_debug.Log("enter: evaluateDirect")
-	// Build environment with unified API functions.
+	if lengthErr := checkExprLength(exprStr); lengthErr != nil {
+		return nil, lengthErr
+	}
+
+	// Build environment with unified API functions. The ctx entry lets the
+	// VM observe the evaluation deadline (see runWithLimits).
 	evalEnv := e.buildEnvironment(env)
+	evalEnv[exprContextEnvKey] = context.Background()
 
 	// Compile and run expression.
-	program, err := expr.Compile(exprStr, expr.Env(evalEnv))
+	program, err := expr.Compile(exprStr, expr.Env(evalEnv), expr.WithContext(exprContextEnvKey))
 	if err != nil {
 		return nil, fmt.Errorf("expression compilation failed: %w", err)
 	}
 
-	result, err := expr.Run(program, evalEnv)
+	result, err := runWithLimits(program, evalEnv, exprStr, expr.Run)
 	if err != nil {
+		var limitErr *LimitError
+		if errors.As(err, &limitErr) {
+			return nil, limitErr
+		}
 		return nil, fmt.Errorf("expression execution failed: %w", err)
 	}
 
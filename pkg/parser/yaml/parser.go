@@ -128,6 +128,12 @@ func parseManifest[T any](
 		return nil, err
 	}
 
+	if kind == "workflow" {
+		if data, err = expandResourceTemplates(data); err != nil {
+			return nil, err
+		}
+	}
+
 	var out T
 	if parseErr := yaml.Unmarshal(data, &out); parseErr != nil {
 		return nil, domain.NewError(
@@ -159,10 +165,18 @@ func (p *Parser) ParseWorkflow(path string) (*domain.Workflow, error) {
 		return nil, loadErr
 	}
 
+	if includeErr := p.loadIncludes(workflow, path); includeErr != nil {
+		return nil, includeErr
+	}
+
 	if compErr := p.loadComponents(workflow, path); compErr != nil {
 		return nil, compErr
 	}
 
+	if constErr := ResolveWorkflowConstants(workflow); constErr != nil {
+		return nil, constErr
+	}
+
 	return workflow, nil
 }
 